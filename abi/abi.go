@@ -0,0 +1,107 @@
+// Package abi encodes and decodes int256.Int values as Solidity ABI intN
+// values, both as standard 32-byte words and in the abi.encodePacked form.
+package abi
+
+import (
+	"errors"
+
+	"github.com/KyberNetwork/int256"
+)
+
+// WordSize is the size in bytes of a standard ABI word.
+const WordSize = 32
+
+var (
+	ErrInvalidBits     = errors.New("abi: intN bit size must be a multiple of 8 between 8 and 256")
+	ErrOutOfRange      = errors.New("abi: value out of range for intN")
+	ErrNotSignExtended = errors.New("abi: word is not a sign-extended intN")
+	ErrLength          = errors.New("abi: input has wrong length")
+)
+
+// EncodeInt returns the 32-byte ABI word of x as an intN value, sign-extended to 256 bits.
+func EncodeInt(x *int256.Int, bits int) ([WordSize]byte, error) {
+	var word [WordSize]byte
+	if err := checkRange(x, bits); err != nil {
+		return word, err
+	}
+	x.WriteToArray32(&word)
+	return word, nil
+}
+
+// AppendInt appends the 32-byte ABI word of x as an intN value to buf.
+func AppendInt(buf []byte, x *int256.Int, bits int) ([]byte, error) {
+	word, err := EncodeInt(x, bits)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, word[:]...), nil
+}
+
+// DecodeInt decodes a 32-byte ABI word holding an intN value. Like the
+// Solidity decoder, it rejects words whose upper bits are not a sign
+// extension of bit N-1.
+func DecodeInt(word []byte, bits int) (*int256.Int, error) {
+	if !validBits(bits) {
+		return nil, ErrInvalidBits
+	}
+	if len(word) != WordSize {
+		return nil, ErrLength
+	}
+	z := new(int256.Int).SetBytes32(word)
+	if !fits(z, bits) {
+		return nil, ErrNotSignExtended
+	}
+	return z, nil
+}
+
+// AppendPackedInt appends x as an intN value in the abi.encodePacked form,
+// which is the N/8 byte big-endian two's complement representation.
+func AppendPackedInt(buf []byte, x *int256.Int, bits int) ([]byte, error) {
+	word, err := EncodeInt(x, bits)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, word[WordSize-bits/8:]...), nil
+}
+
+// DecodePackedInt decodes an intN value in the abi.encodePacked form; b must be exactly N/8 bytes long.
+func DecodePackedInt(b []byte, bits int) (*int256.Int, error) {
+	if !validBits(bits) {
+		return nil, ErrInvalidBits
+	}
+	if len(b) != bits/8 {
+		return nil, ErrLength
+	}
+	var word [WordSize]byte
+	if b[0]&0x80 != 0 {
+		for i := range word {
+			word[i] = 0xff
+		}
+	}
+	copy(word[WordSize-len(b):], b)
+	return new(int256.Int).SetBytes32(word[:]), nil
+}
+
+func validBits(bits int) bool {
+	return bits >= 8 && bits <= 256 && bits%8 == 0
+}
+
+func checkRange(x *int256.Int, bits int) error {
+	if !validBits(bits) {
+		return ErrInvalidBits
+	}
+	if !fits(x, bits) {
+		return ErrOutOfRange
+	}
+	return nil
+}
+
+// fits reports whether x is in the range of intN, i.e. whether bits N-1 to 255 are all equal.
+func fits(x *int256.Int, bits int) bool {
+	if bits == 256 {
+		return true
+	}
+	var t int256.Int
+	t.Rsh(x, uint(bits-1))
+	return t.IsZero() || t.Eq(int256.NewInt(-1))
+}
@@ -0,0 +1,113 @@
+package abi
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/KyberNetwork/int256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeInt(t *testing.T) {
+	tests := []struct {
+		dec  string
+		bits int
+		word string
+	}{
+		{"0", 8, strings.Repeat("00", 32)},
+		{"127", 8, strings.Repeat("00", 31) + "7f"},
+		{"-128", 8, strings.Repeat("ff", 31) + "80"},
+		{"-1", 24, strings.Repeat("ff", 32)},
+		{"-9223372036854775808", 64, strings.Repeat("ff", 24) + "8000000000000000"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 256, "80" + strings.Repeat("00", 31)},
+	}
+	for i, test := range tests {
+		x := int256.MustFromDec(test.dec)
+		word, err := EncodeInt(x, test.bits)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.word, hex.EncodeToString(word[:]), "test %d", i)
+
+		buf, err := AppendInt([]byte{0xaa}, x, test.bits)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, append([]byte{0xaa}, word[:]...), buf, "test %d", i)
+
+		z, err := DecodeInt(word[:], test.bits)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, x, z, "test %d", i)
+	}
+}
+
+func TestEncodeIntError(t *testing.T) {
+	tests := []struct {
+		dec  string
+		bits int
+		err  error
+	}{
+		{"128", 8, ErrOutOfRange},
+		{"-129", 8, ErrOutOfRange},
+		{"9223372036854775808", 64, ErrOutOfRange},
+		{"1", 0, ErrInvalidBits},
+		{"1", 12, ErrInvalidBits},
+		{"1", 264, ErrInvalidBits},
+	}
+	for i, test := range tests {
+		_, err := EncodeInt(int256.MustFromDec(test.dec), test.bits)
+		assert.ErrorIs(t, err, test.err, "test %d", i)
+		_, err = AppendPackedInt(nil, int256.MustFromDec(test.dec), test.bits)
+		assert.ErrorIs(t, err, test.err, "test %d", i)
+	}
+}
+
+func TestDecodeIntError(t *testing.T) {
+	t.Run("1. should reject dirty upper bits", func(t *testing.T) {
+		word, _ := hex.DecodeString(strings.Repeat("00", 31) + "80")
+		_, err := DecodeInt(word, 8)
+		assert.ErrorIs(t, err, ErrNotSignExtended)
+		z, err := DecodeInt(word, 16)
+		assert.Nil(t, err)
+		assert.Equal(t, "128", z.Dec())
+	})
+
+	t.Run("2. should reject dirty upper bits", func(t *testing.T) {
+		word, _ := hex.DecodeString(strings.Repeat("ff", 30) + "7fff")
+		_, err := DecodeInt(word, 16)
+		assert.ErrorIs(t, err, ErrNotSignExtended)
+	})
+
+	t.Run("3. should reject wrong length", func(t *testing.T) {
+		_, err := DecodeInt(make([]byte, 31), 8)
+		assert.ErrorIs(t, err, ErrLength)
+		_, err = DecodeInt(make([]byte, 32), 7)
+		assert.ErrorIs(t, err, ErrInvalidBits)
+	})
+}
+
+func TestPackedInt(t *testing.T) {
+	tests := []struct {
+		dec    string
+		bits   int
+		packed string
+	}{
+		{"-1", 8, "ff"},
+		{"-2", 16, "fffe"},
+		{"300", 24, "00012c"},
+		{"-300", 128, strings.Repeat("ff", 14) + "fed4"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", 256, "7f" + strings.Repeat("ff", 31)},
+	}
+	for i, test := range tests {
+		x := int256.MustFromDec(test.dec)
+		b, err := AppendPackedInt(nil, x, test.bits)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.packed, hex.EncodeToString(b), "test %d", i)
+
+		z, err := DecodePackedInt(b, test.bits)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, x, z, "test %d", i)
+	}
+
+	t.Run("should reject wrong length", func(t *testing.T) {
+		_, err := DecodePackedInt([]byte{1, 2}, 8)
+		assert.ErrorIs(t, err, ErrLength)
+	})
+}
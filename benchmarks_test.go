@@ -804,3 +804,56 @@ func BenchmarkSqrt(bench *testing.B) {
 	bench.Run("big", sqrtbig)
 	bench.Run("int256", sqrtint256)
 }
+
+func BenchmarkDec(bench *testing.B) {
+	var (
+		// 2^255 - 1
+		lim, _ = new(big.Int).SetString("57896044618658097711785492504343953926634992332820282019728792003956564819967", 10)
+		rnd    = rand.New(rand.NewSource(rand.Int63()))
+
+		testcasesBI   = []*big.Int{}
+		testcasesI256 = []*Int{}
+	)
+
+	for i := 0; i < 200; i++ {
+		x := new(big.Int).Rand(rnd, lim)
+		testcasesBI = append(testcasesBI, x, new(big.Int).Neg(x))
+		testcasesI256 = append(testcasesI256, MustFromBig(x), MustFromBig(new(big.Int).Neg(x)))
+	}
+
+	sz := len(testcasesBI)
+
+	decint256 := func(bench *testing.B) {
+		testID := 0
+		bench.ResetTimer()
+		for i := 0; i < bench.N; i++ {
+			testID = i % sz
+			_ = testcasesI256[testID].Dec()
+		}
+	}
+
+	appenddecint256 := func(bench *testing.B) {
+		var (
+			testID = 0
+			buf    = make([]byte, 0, 78)
+		)
+		bench.ResetTimer()
+		for i := 0; i < bench.N; i++ {
+			testID = i % sz
+			buf = testcasesI256[testID].AppendDec(buf[:0])
+		}
+	}
+
+	decbig := func(bench *testing.B) {
+		testID := 0
+		bench.ResetTimer()
+		for i := 0; i < bench.N; i++ {
+			testID = i % sz
+			_ = testcasesBI[testID].String()
+		}
+	}
+
+	bench.Run("big", decbig)
+	bench.Run("int256", decint256)
+	bench.Run("int256/append", appenddecint256)
+}
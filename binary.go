@@ -0,0 +1,76 @@
+package int256
+
+import (
+	"errors"
+	"fmt"
+)
+
+// binaryVersion is the first byte of the MarshalBinary encoding.
+const binaryVersion byte = 1
+
+var ErrInvalidEncoding = errors.New("int256: invalid encoding")
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a version
+// byte (currently 1) followed by the shortest big-endian two's complement
+// representation of z; zero has no value bytes, so it encodes as a single byte.
+func (z *Int) MarshalBinary() ([]byte, error) {
+	return z.appendMinimal(append(make([]byte, 0, 33), binaryVersion)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the format written by MarshalBinary.
+func (z *Int) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidEncoding
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("int256: unsupported binary encoding version %d", data[0])
+	}
+	return z.setMinimal(data[1:])
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding.
+func (z *Int) GobEncode() ([]byte, error) {
+	return z.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the MarshalBinary encoding.
+func (z *Int) GobDecode(data []byte) error {
+	return z.UnmarshalBinary(data)
+}
+
+// minimalLen returns the length of the shortest big-endian two's complement
+// representation of z, which is zero for z == 0.
+func (z *Int) minimalLen() int {
+	if z.IsZero() {
+		return 0
+	}
+	x := *z
+	if x.IsNegative() {
+		x.Not(&x)
+	}
+	// one extra bit is needed for the sign
+	return x.BitLen()/8 + 1
+}
+
+// appendMinimal appends the shortest big-endian two's complement representation of z to dst.
+func (z *Int) appendMinimal(dst []byte) []byte {
+	var b [32]byte
+	z.WriteToArray32(&b)
+	return append(dst, b[32-z.minimalLen():]...)
+}
+
+// setMinimal sets z to the sign-extended value of the big-endian two's complement bytes b.
+func (z *Int) setMinimal(b []byte) error {
+	if len(b) > 32 {
+		return ErrOverflow
+	}
+	var buf [32]byte
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		for i := range buf {
+			buf[i] = 0xff
+		}
+	}
+	copy(buf[32-len(b):], b)
+	z.SetBytes32(buf[:])
+	return nil
+}
@@ -0,0 +1,67 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []struct {
+		dec string
+		bin []byte
+	}{
+		{"0", []byte{1}},
+		{"1", []byte{1, 0x01}},
+		{"-1", []byte{1, 0xff}},
+		{"127", []byte{1, 0x7f}},
+		{"128", []byte{1, 0x00, 0x80}},
+		{"-128", []byte{1, 0x80}},
+		{"-129", []byte{1, 0xff, 0x7f}},
+		{"65535", []byte{1, 0x00, 0xff, 0xff}},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", append([]byte{1, 0x80}, make([]byte, 31)...)},
+	}
+	for i, test := range tests {
+		b, err := MustFromDec(test.dec).MarshalBinary()
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.bin, b, "test %d", i)
+
+		z := NewInt(7)
+		err = z.UnmarshalBinary(test.bin)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.dec, z.Dec(), "test %d", i)
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	t.Run("1. should return error on empty input", func(t *testing.T) {
+		assert.ErrorIs(t, new(Int).UnmarshalBinary(nil), ErrInvalidEncoding)
+	})
+
+	t.Run("2. should return error on unknown version", func(t *testing.T) {
+		assert.Error(t, new(Int).UnmarshalBinary([]byte{2, 1}))
+	})
+
+	t.Run("3. should return error overflow", func(t *testing.T) {
+		assert.ErrorIs(t, new(Int).UnmarshalBinary(append([]byte{1}, make([]byte, 33)...)), ErrOverflow)
+	})
+}
+
+func TestGob(t *testing.T) {
+	type snapshot struct {
+		Reserve *Int
+		Amounts []*Int
+	}
+	in := snapshot{
+		Reserve: MustFromDec("-57896044618658097711785492504343953926634992332820282019728792003956564819968"),
+		Amounts: []*Int{NewInt(0), NewInt(-1), MaxI256},
+	}
+	var buf bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buf).Encode(in))
+
+	var out snapshot
+	assert.Nil(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
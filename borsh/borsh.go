@@ -0,0 +1,64 @@
+// Package borsh encodes and decodes int256.Int values as the Borsh i128 and
+// i256 types, which are fixed-width little-endian two's complement integers
+// as used by Solana and NEAR programs.
+package borsh
+
+import (
+	"io"
+
+	"github.com/KyberNetwork/int256"
+)
+
+const (
+	I128Size = 16
+	I256Size = 32
+)
+
+// AppendI128 appends x as a Borsh i128 to buf. It fails with int256.ErrOverflow
+// if x is outside the signed 128-bit range.
+func AppendI128(buf []byte, x *int256.Int) ([]byte, error) {
+	if !fitsI128(x) {
+		return buf, int256.ErrOverflow
+	}
+	var b [32]byte
+	x.WriteToArray32LE(&b)
+	return append(buf, b[:I128Size]...), nil
+}
+
+// AppendI256 appends x as a Borsh i256 to buf.
+func AppendI256(buf []byte, x *int256.Int) []byte {
+	var b [32]byte
+	x.WriteToArray32LE(&b)
+	return append(buf, b[:]...)
+}
+
+// DecodeI128 decodes a Borsh i128 from the start of b and returns the value and the remaining bytes.
+func DecodeI128(b []byte) (*int256.Int, []byte, error) {
+	if len(b) < I128Size {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	var buf [32]byte
+	copy(buf[:], b[:I128Size])
+	if b[I128Size-1]&0x80 != 0 {
+		for i := I128Size; i < len(buf); i++ {
+			buf[i] = 0xff
+		}
+	}
+	return new(int256.Int).SetBytes32LE(buf[:]), b[I128Size:], nil
+}
+
+// DecodeI256 decodes a Borsh i256 from the start of b and returns the value and the remaining bytes.
+func DecodeI256(b []byte) (*int256.Int, []byte, error) {
+	if len(b) < I256Size {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	return new(int256.Int).SetBytes32LE(b), b[I256Size:], nil
+}
+
+// fitsI128 reports whether the upper 128 bits of x are a sign extension of bit 127.
+func fitsI128(x *int256.Int) bool {
+	if x[1]&0x8000000000000000 != 0 {
+		return x[2]&x[3] == 0xffffffffffffffff
+	}
+	return x[2]|x[3] == 0
+}
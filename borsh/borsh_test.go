@@ -0,0 +1,75 @@
+package borsh
+
+import (
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/KyberNetwork/int256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestI128(t *testing.T) {
+	tests := []struct {
+		dec   string
+		borsh string
+	}{
+		{"0", strings.Repeat("00", 16)},
+		{"1", "01" + strings.Repeat("00", 15)},
+		{"-1", strings.Repeat("ff", 16)},
+		{"-2", "fe" + strings.Repeat("ff", 15)},
+		{"170141183460469231731687303715884105727", strings.Repeat("ff", 15) + "7f"},
+		{"-170141183460469231731687303715884105728", strings.Repeat("00", 15) + "80"},
+	}
+	for i, test := range tests {
+		x := int256.MustFromDec(test.dec)
+		b, err := AppendI128([]byte{0xaa}, x)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, "aa"+test.borsh, hex.EncodeToString(b), "test %d", i)
+
+		z, rest, err := DecodeI128(append(b[1:], 0xbb))
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, []byte{0xbb}, rest, "test %d", i)
+		assert.Equal(t, x, z, "test %d", i)
+	}
+
+	t.Run("should return error overflow", func(t *testing.T) {
+		for i, dec := range []string{"170141183460469231731687303715884105728", "-170141183460469231731687303715884105729", "-57896044618658097711785492504343953926634992332820282019728792003956564819968"} {
+			_, err := AppendI128(nil, int256.MustFromDec(dec))
+			assert.ErrorIs(t, err, int256.ErrOverflow, "test %d", i)
+		}
+	})
+
+	t.Run("should return error unexpected EOF", func(t *testing.T) {
+		_, _, err := DecodeI128(make([]byte, 15))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func TestI256(t *testing.T) {
+	tests := []struct {
+		dec   string
+		borsh string
+	}{
+		{"0", strings.Repeat("00", 32)},
+		{"-2", "fe" + strings.Repeat("ff", 31)},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", strings.Repeat("ff", 31) + "7f"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", strings.Repeat("00", 31) + "80"},
+	}
+	for i, test := range tests {
+		x := int256.MustFromDec(test.dec)
+		b := AppendI256(nil, x)
+		assert.Equal(t, test.borsh, hex.EncodeToString(b), "test %d", i)
+
+		z, rest, err := DecodeI256(b)
+		assert.Nil(t, err, "test %d", i)
+		assert.Empty(t, rest, "test %d", i)
+		assert.Equal(t, x, z, "test %d", i)
+	}
+
+	t.Run("should return error unexpected EOF", func(t *testing.T) {
+		_, _, err := DecodeI256(make([]byte, 31))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
@@ -0,0 +1,189 @@
+package int256
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"unsafe"
+)
+
+// hostLittleEndian reports whether the memory layout of an Int is its 32-byte
+// little-endian two's complement representation.
+var hostLittleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+// maxChunkLen bounds how many values the Reader allocates ahead of the data it has read.
+const maxChunkLen = 1 << 16
+
+var (
+	ErrBytesLength = errors.New("int256: byte slice length is not a multiple of 32")
+	ErrFrameLength = errors.New("int256: frame length out of range")
+)
+
+func isLittleEndian(order binary.ByteOrder) bool {
+	switch order {
+	case binary.LittleEndian:
+		return true
+	case binary.BigEndian:
+		return false
+	case binary.NativeEndian:
+		return hostLittleEndian
+	}
+	return order.Uint16([]byte{1, 0}) == 1
+}
+
+// IntsFromBytes interprets b as consecutive 32-byte two's complement values in
+// the given byte order. For little-endian data on a little-endian host with b
+// suitably aligned, the result shares memory with b and no copy is made;
+// otherwise the values are copied.
+func IntsFromBytes(b []byte, order binary.ByteOrder) ([]Int, error) {
+	if len(b)%32 != 0 {
+		return nil, ErrBytesLength
+	}
+	if len(b) == 0 {
+		return []Int{}, nil
+	}
+	little := isLittleEndian(order)
+	if little && hostLittleEndian && uintptr(unsafe.Pointer(&b[0]))%unsafe.Alignof(Int{}) == 0 {
+		return unsafe.Slice((*Int)(unsafe.Pointer(&b[0])), len(b)/32), nil
+	}
+	xs := make([]Int, len(b)/32)
+	decodeInts(xs, b, little)
+	return xs, nil
+}
+
+// IntsToBytes returns xs as consecutive 32-byte two's complement values in
+// the given byte order. For little-endian output on a little-endian host the
+// result shares memory with xs and no copy is made; otherwise a new buffer is
+// allocated.
+func IntsToBytes(xs []Int, order binary.ByteOrder) []byte {
+	if len(xs) == 0 {
+		return []byte{}
+	}
+	little := isLittleEndian(order)
+	if little && hostLittleEndian {
+		return unsafe.Slice((*byte)(unsafe.Pointer(&xs[0])), 32*len(xs))
+	}
+	b := make([]byte, 32*len(xs))
+	encodeInts(b, xs, little)
+	return b
+}
+
+func decodeInts(xs []Int, b []byte, little bool) {
+	for i := range xs {
+		if little {
+			xs[i].SetBytes32LE(b[32*i:])
+		} else {
+			xs[i].SetBytes32(b[32*i:])
+		}
+	}
+}
+
+func encodeInts(b []byte, xs []Int, little bool) {
+	for i := range xs {
+		dest := (*[32]byte)(b[32*i:])
+		if little {
+			xs[i].WriteToArray32LE(dest)
+		} else {
+			xs[i].WriteToArray32(dest)
+		}
+	}
+}
+
+// Writer writes framed arrays of Int to an underlying io.Writer. Each frame is
+// the number of values as a uvarint followed by the values as 32-byte two's
+// complement in the Writer's byte order.
+type Writer struct {
+	w      io.Writer
+	little bool
+	buf    []byte
+}
+
+func NewWriter(w io.Writer, order binary.ByteOrder) *Writer {
+	return &Writer{w: w, little: isLittleEndian(order)}
+}
+
+// WriteInts writes xs as one frame.
+func (w *Writer) WriteInts(xs []Int) error {
+	var hdr [binary.MaxVarintLen64]byte
+	if _, err := w.w.Write(binary.AppendUvarint(hdr[:0], uint64(len(xs)))); err != nil {
+		return err
+	}
+	if w.little && hostLittleEndian {
+		_, err := w.w.Write(IntsToBytes(xs, binary.LittleEndian))
+		return err
+	}
+	for len(xs) > 0 {
+		n := min(len(xs), maxChunkLen)
+		if cap(w.buf) < 32*n {
+			w.buf = make([]byte, 32*n)
+		}
+		b := w.buf[:32*n]
+		encodeInts(b, xs[:n], w.little)
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+		xs = xs[n:]
+	}
+	return nil
+}
+
+// Reader reads framed arrays of Int written by a Writer with the same byte order.
+type Reader struct {
+	r      *bufio.Reader
+	little bool
+	buf    []byte
+}
+
+func NewReader(r io.Reader, order binary.ByteOrder) *Reader {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Reader{r: br, little: isLittleEndian(order)}
+}
+
+// ReadInts reads the next frame. It returns io.EOF when no frames are left
+// and io.ErrUnexpectedEOF when the input ends inside a frame.
+func (r *Reader) ReadInts() ([]Int, error) {
+	count, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(math.MaxInt/32) {
+		return nil, ErrFrameLength
+	}
+
+	// grow the result while reading so a corrupt count cannot force a huge allocation
+	xs := make([]Int, 0, min(int(count), maxChunkLen))
+	for remaining := int(count); remaining > 0; {
+		n := min(remaining, maxChunkLen)
+		start := len(xs)
+		xs = append(xs, make([]Int, n)...)
+		if err := r.readChunk(xs[start:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		remaining -= n
+	}
+	return xs, nil
+}
+
+func (r *Reader) readChunk(xs []Int) error {
+	if r.little && hostLittleEndian {
+		_, err := io.ReadFull(r.r, IntsToBytes(xs, binary.LittleEndian))
+		return err
+	}
+	if cap(r.buf) < 32*len(xs) {
+		r.buf = make([]byte, 32*len(xs))
+	}
+	b := r.buf[:32*len(xs)]
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return err
+	}
+	decodeInts(xs, b, r.little)
+	return nil
+}
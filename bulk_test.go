@@ -0,0 +1,115 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bulkTestValues() []Int {
+	return []Int{*NewInt(0), *NewInt(-1), *MinI256, *MaxI256, *MustFromDec("12345678431937219573219471295439254379564372953245")}
+}
+
+func TestIntsFromBytes(t *testing.T) {
+	xs := bulkTestValues()
+
+	t.Run("1. should match SetBytes32 and SetBytes32LE", func(t *testing.T) {
+		for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian, binary.NativeEndian} {
+			b := IntsToBytes(xs, order)
+			assert.Equal(t, 32*len(xs), len(b))
+			for i := range xs {
+				var word [32]byte
+				if isLittleEndian(order) {
+					xs[i].WriteToArray32LE(&word)
+				} else {
+					xs[i].WriteToArray32(&word)
+				}
+				assert.Equal(t, word[:], b[32*i:32*(i+1)])
+			}
+
+			out, err := IntsFromBytes(bytes.Clone(b), order)
+			assert.Nil(t, err)
+			assert.Equal(t, xs, out)
+		}
+	})
+
+	t.Run("2. should handle unaligned input", func(t *testing.T) {
+		buf := make([]byte, 1+32*len(xs))
+		copy(buf[1:], IntsToBytes(xs, binary.LittleEndian))
+		out, err := IntsFromBytes(buf[1:], binary.LittleEndian)
+		assert.Nil(t, err)
+		assert.Equal(t, xs, out)
+	})
+
+	t.Run("3. should share memory on little-endian hosts", func(t *testing.T) {
+		if !hostLittleEndian {
+			t.Skip("big-endian host")
+		}
+		b := make([]byte, 64)
+		out, err := IntsFromBytes(b, binary.LittleEndian)
+		assert.Nil(t, err)
+		b[32] = 7
+		assert.Equal(t, "7", out[1].Dec())
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = IntsFromBytes(b, binary.LittleEndian)
+			_ = IntsToBytes(out, binary.LittleEndian)
+		})
+		assert.Equal(t, float64(0), allocs)
+	})
+
+	t.Run("4. should return error", func(t *testing.T) {
+		_, err := IntsFromBytes(make([]byte, 31), binary.LittleEndian)
+		assert.ErrorIs(t, err, ErrBytesLength)
+	})
+
+	t.Run("5. should handle empty input", func(t *testing.T) {
+		out, err := IntsFromBytes(nil, binary.BigEndian)
+		assert.Nil(t, err)
+		assert.Empty(t, out)
+		assert.Empty(t, IntsToBytes(nil, binary.BigEndian))
+	})
+}
+
+func TestReaderWriter(t *testing.T) {
+	frames := [][]Int{bulkTestValues(), {}, make([]Int, maxChunkLen+3)}
+	frames[2][maxChunkLen+1] = *NewInt(-5)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, order)
+		for _, f := range frames {
+			assert.Nil(t, w.WriteInts(f))
+		}
+
+		r := NewReader(&buf, order)
+		for _, f := range frames {
+			out, err := r.ReadInts()
+			assert.Nil(t, err)
+			assert.Equal(t, f, out)
+		}
+		_, err := r.ReadInts()
+		assert.ErrorIs(t, err, io.EOF)
+	}
+
+	t.Run("should return error unexpected EOF", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.Nil(t, NewWriter(&buf, binary.BigEndian).WriteInts(bulkTestValues()))
+		_, err := NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), binary.BigEndian).ReadInts()
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		// a corrupt count larger than maxChunkLen must not allocate ahead of the data
+		hdr := binary.AppendUvarint(nil, 1<<20)
+		_, err = NewReader(bytes.NewReader(hdr), binary.LittleEndian).ReadInts()
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("should return error frame length", func(t *testing.T) {
+		hdr := binary.AppendUvarint(nil, 1<<62)
+		_, err := NewReader(bytes.NewReader(hdr), binary.LittleEndian).ReadInts()
+		assert.ErrorIs(t, err, ErrFrameLength)
+	})
+}
@@ -0,0 +1,153 @@
+package int256
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// CBOR major types and tags used by the RFC 8949 integer encodings.
+const (
+	cborUnsigned   = 0
+	cborNegative   = 1
+	cborByteString = 2
+	cborTag        = 6
+
+	cborTagPositiveBignum = 2
+	cborTagNegativeBignum = 3
+)
+
+// MarshalCBOR returns the CBOR encoding of z. It implements the Marshaler
+// interface of github.com/fxamacker/cbor.
+func (z *Int) MarshalCBOR() ([]byte, error) {
+	return z.AppendCBOR(make([]byte, 0, 35)), nil
+}
+
+// AppendCBOR appends the RFC 8949 preferred encoding of z to buf: an unsigned
+// (major type 0) or negative (major type 1) integer when it fits into 64 bits,
+// otherwise a positive (tag 2) or negative (tag 3) bignum.
+func (z *Int) AppendCBOR(buf []byte) []byte {
+	major, tag := byte(cborUnsigned), uint64(cborTagPositiveBignum)
+	n := *z
+	if n.IsNegative() {
+		// negative integers encode -1-z
+		major, tag = cborNegative, cborTagNegativeBignum
+		n.Not(&n)
+	}
+	if n.IsUint64() {
+		return appendCBORHead(buf, major, n[0])
+	}
+
+	var b [32]byte
+	n.WriteToArray32(&b)
+	size := (n.BitLen() + 7) / 8
+	buf = appendCBORHead(buf, cborTag, tag)
+	buf = appendCBORHead(buf, cborByteString, uint64(size))
+	return append(buf, b[32-size:]...)
+}
+
+func appendCBORHead(buf []byte, major byte, v uint64) []byte {
+	major <<= 5
+	switch {
+	case v < 24:
+		return append(buf, major|byte(v))
+	case v <= 0xff:
+		return append(buf, major|24, byte(v))
+	case v <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(buf, major|25), uint16(v))
+	case v <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(buf, major|26), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(buf, major|27), v)
+}
+
+// UnmarshalCBOR sets z from data, which must hold exactly one CBOR integer or
+// tag 2/3 bignum. It implements the Unmarshaler interface of github.com/fxamacker/cbor.
+func (z *Int) UnmarshalCBOR(data []byte) error {
+	rest, err := z.readCBOR(data)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
+func (z *Int) readCBOR(b []byte) ([]byte, error) {
+	major, v, b, err := readCBORHead(b)
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case cborUnsigned:
+		z.SetUint64(v)
+		return b, nil
+	case cborNegative:
+		z.SetUint64(v)
+		z.Not(z)
+		return b, nil
+	case cborTag:
+		if v != cborTagPositiveBignum && v != cborTagNegativeBignum {
+			return nil, ErrInvalidEncoding
+		}
+	default:
+		return nil, ErrInvalidEncoding
+	}
+
+	tag := v
+	major, size, b, err := readCBORHead(b)
+	if err != nil {
+		return nil, err
+	}
+	if major != cborByteString {
+		return nil, ErrInvalidEncoding
+	}
+	if uint64(len(b)) < size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	payload := b[:size]
+	for len(payload) > 0 && payload[0] == 0 {
+		payload = payload[1:]
+	}
+	if len(payload) > 32 || (len(payload) == 32 && payload[0]&0x80 != 0) {
+		return nil, ErrOverflow
+	}
+	var buf [32]byte
+	copy(buf[32-len(payload):], payload)
+	z.SetBytes32(buf[:])
+	if tag == cborTagNegativeBignum {
+		z.Not(z)
+	}
+	return b[size:], nil
+}
+
+// readCBORHead decodes the initial byte and argument of a data item with a definite argument.
+func readCBORHead(b []byte) (major byte, v uint64, rest []byte, err error) {
+	if len(b) == 0 {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	b = b[1:]
+	var n int
+	switch {
+	case info < 24:
+		return major, uint64(info), b, nil
+	case info == 24:
+		n = 1
+	case info == 25:
+		n = 2
+	case info == 26:
+		n = 4
+	case info == 27:
+		n = 8
+	default:
+		return 0, 0, nil, ErrInvalidEncoding
+	}
+	if len(b) < n {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	for _, c := range b[:n] {
+		v = v<<8 | uint64(c)
+	}
+	return major, v, b[n:], nil
+}
@@ -0,0 +1,85 @@
+package int256
+
+import (
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCBOR(t *testing.T) {
+	// vectors from RFC 8949 appendix A, plus the int256 range boundaries
+	tests := []struct {
+		dec  string
+		cbor string
+	}{
+		{"0", "00"},
+		{"1", "01"},
+		{"10", "0a"},
+		{"23", "17"},
+		{"24", "1818"},
+		{"100", "1864"},
+		{"1000", "1903e8"},
+		{"1000000", "1a000f4240"},
+		{"1000000000000", "1b000000e8d4a51000"},
+		{"18446744073709551615", "1bffffffffffffffff"},
+		{"18446744073709551616", "c249010000000000000000"},
+		{"-18446744073709551616", "3bffffffffffffffff"},
+		{"-18446744073709551617", "c349010000000000000000"},
+		{"-1", "20"},
+		{"-10", "29"},
+		{"-100", "3863"},
+		{"-1000", "3903e7"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", "c258207fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "c358207fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		b, err := z.MarshalCBOR()
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.cbor, hex.EncodeToString(b), "test %d", i)
+
+		var d Int
+		assert.Nil(t, d.UnmarshalCBOR(b), "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+	}
+}
+
+func TestUnmarshalCBOR(t *testing.T) {
+	t.Run("1. should accept non-preferred bignums", func(t *testing.T) {
+		var z Int
+		b, _ := hex.DecodeString("c243000001")
+		assert.Nil(t, z.UnmarshalCBOR(b))
+		assert.Equal(t, "1", z.Dec())
+
+		b, _ = hex.DecodeString("c340")
+		assert.Nil(t, z.UnmarshalCBOR(b))
+		assert.Equal(t, "-1", z.Dec())
+	})
+
+	t.Run("2. should return error", func(t *testing.T) {
+		tests := []struct {
+			cbor string
+			err  error
+		}{
+			{"", io.ErrUnexpectedEOF},
+			{"19ff", io.ErrUnexpectedEOF},
+			{"c24201", io.ErrUnexpectedEOF},
+			{"0000", ErrInvalidEncoding},
+			{"1f", ErrInvalidEncoding},
+			{"4101", ErrInvalidEncoding},
+			{"c101", ErrInvalidEncoding},
+			{"c201", ErrInvalidEncoding},
+			{"c25f41014100ff", ErrInvalidEncoding},
+			{"c258208000000000000000000000000000000000000000000000000000000000000000", ErrOverflow},
+			{"c35820ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ErrOverflow},
+			{"c3582101000000000000000000000000000000000000000000000000000000000000000000", ErrOverflow},
+		}
+		for i, test := range tests {
+			b, _ := hex.DecodeString(test.cbor)
+			var z Int
+			assert.ErrorIs(t, z.UnmarshalCBOR(b), test.err, "test %d", i)
+		}
+	})
+}
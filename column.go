@@ -0,0 +1,90 @@
+package int256
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ClickHouse Int256 columns and Arrow Decimal256 arrays (and Parquet DECIMAL
+// columns written through Arrow) store each value as 32 little-endian bytes of
+// two's complement, back to back. For Decimal256 the stored integer is the
+// unscaled value, i.e. the decimal value times 10^scale.
+
+const (
+	ColumnValueSize        = 32
+	MaxDecimal256Precision = 76
+)
+
+var (
+	ErrColumnLength     = errors.New("int256: column buffer length is not a multiple of 32")
+	ErrDecimalPrecision = errors.New("int256: decimal precision must be between 1 and 76 and scale between 0 and precision")
+)
+
+// AppendInt256Column appends xs to dst in the ClickHouse Int256 column layout.
+func AppendInt256Column(dst []byte, xs []Int) []byte {
+	n := len(dst)
+	dst = growBytes(dst, ColumnValueSize*len(xs))
+	for i := range xs {
+		xs[i].WriteToArray32LE((*[ColumnValueSize]byte)(dst[n+ColumnValueSize*i:]))
+	}
+	return dst
+}
+
+// DecodeInt256Column decodes a buffer in the ClickHouse Int256 column layout.
+func DecodeInt256Column(b []byte) ([]Int, error) {
+	if len(b)%ColumnValueSize != 0 {
+		return nil, ErrColumnLength
+	}
+	xs := make([]Int, len(b)/ColumnValueSize)
+	for i := range xs {
+		xs[i].SetBytes32LE(b[ColumnValueSize*i:])
+	}
+	return xs, nil
+}
+
+// AppendDecimal256Column appends the unscaled values xs to dst in the Arrow
+// Decimal256 layout, checking that each value has at most precision digits.
+func AppendDecimal256Column(dst []byte, xs []Int, precision, scale int) ([]byte, error) {
+	if err := checkDecimal256(xs, precision, scale); err != nil {
+		return dst, err
+	}
+	return AppendInt256Column(dst, xs), nil
+}
+
+// DecodeDecimal256Column decodes unscaled values in the Arrow Decimal256
+// layout, checking that each value has at most precision digits.
+func DecodeDecimal256Column(b []byte, precision, scale int) ([]Int, error) {
+	xs, err := DecodeInt256Column(b)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDecimal256(xs, precision, scale); err != nil {
+		return nil, err
+	}
+	return xs, nil
+}
+
+func checkDecimal256(xs []Int, precision, scale int) error {
+	if precision < 1 || precision > MaxDecimal256Precision || scale < 0 || scale > precision {
+		return ErrDecimalPrecision
+	}
+	var hi, lo Int
+	hi.pow10(precision)
+	lo.Neg(&hi)
+	for i := range xs {
+		if !xs[i].Lt(&hi) || !xs[i].Gt(&lo) {
+			return fmt.Errorf("int256: value %s at index %d exceeds DECIMAL(%d, %d): %w", xs[i].Dec(), i, precision, scale, ErrOverflow)
+		}
+	}
+	return nil
+}
+
+// growBytes extends b by n bytes, reallocating at most once.
+func growBytes(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		nb := make([]byte, len(b), len(b)+n)
+		copy(nb, b)
+		b = nb
+	}
+	return b[:len(b)+n]
+}
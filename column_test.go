@@ -0,0 +1,73 @@
+package int256
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt256Column(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		xs := []Int{*NewInt(1), *NewInt(-2), *MinI256}
+		b := AppendInt256Column([]byte{0xaa}, xs)
+		assert.Equal(t, "aa"+
+			"01"+strings.Repeat("00", 31)+
+			"fe"+strings.Repeat("ff", 31)+
+			strings.Repeat("00", 31)+"80", hex.EncodeToString(b))
+
+		out, err := DecodeInt256Column(b[1:])
+		assert.Nil(t, err)
+		assert.Equal(t, xs, out)
+	})
+
+	t.Run("2. should return empty result", func(t *testing.T) {
+		assert.Empty(t, AppendInt256Column(nil, nil))
+		out, err := DecodeInt256Column(nil)
+		assert.Nil(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("3. should return error", func(t *testing.T) {
+		_, err := DecodeInt256Column(make([]byte, 33))
+		assert.ErrorIs(t, err, ErrColumnLength)
+	})
+}
+
+func TestDecimal256Column(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		xs := []Int{*MustFromDec("99999"), *MustFromDec("-99999"), *NewInt(0)}
+		b, err := AppendDecimal256Column(nil, xs, 5, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, AppendInt256Column(nil, xs), b)
+
+		out, err := DecodeDecimal256Column(b, 5, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, xs, out)
+	})
+
+	t.Run("2. should accept maximum precision", func(t *testing.T) {
+		xs := []Int{*MustFromDec("-" + strings.Repeat("9", 76))}
+		_, err := AppendDecimal256Column(nil, xs, 76, 0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("3. should return error overflow", func(t *testing.T) {
+		_, err := AppendDecimal256Column(nil, []Int{*NewInt(1), *MustFromDec("-100000")}, 5, 0)
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.EqualError(t, err, "int256: value -100000 at index 1 exceeds DECIMAL(5, 0): int256: overflow")
+
+		_, err = DecodeDecimal256Column(AppendInt256Column(nil, []Int{*MaxI256}), 76, 0)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+
+	t.Run("4. should return error precision", func(t *testing.T) {
+		for i, ps := range [][2]int{{0, 0}, {77, 0}, {10, -1}, {10, 11}} {
+			_, err := AppendDecimal256Column(nil, nil, ps[0], ps[1])
+			assert.ErrorIs(t, err, ErrDecimalPrecision, "test %d", i)
+			_, err = DecodeDecimal256Column(nil, ps[0], ps[1])
+			assert.ErrorIs(t, err, ErrDecimalPrecision, "test %d", i)
+		}
+	})
+}
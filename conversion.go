@@ -2,7 +2,6 @@ package int256
 
 import (
 	"errors"
-	"io"
 	"math/big"
 	"math/bits"
 	"strconv"
@@ -14,7 +13,10 @@ const (
 )
 
 var (
-	ErrOverflow = errors.New("int256: overflow")
+	ErrOverflow    = errors.New("int256: overflow")
+	ErrSyntax      = errors.New("int256: invalid syntax")
+	ErrEmptyNumber = errors.New("int256: empty number")
+	ErrNotInteger  = errors.New("int256: not an integer")
 
 	multipliers = [5]*Int{
 		nil,
@@ -25,6 +27,40 @@ var (
 	}
 )
 
+const tenPow19 = 10000000000000000000
+
+// reciprocalTenPow19 is the reciprocal of 10^19 for udivrem2by1; 10^19 is
+// already normalized, its top bit being set.
+var reciprocalTenPow19 = reciprocal2by1(tenPow19)
+
+const decPairs = "00010203040506070809" +
+	"10111213141516171819" +
+	"20212223242526272829" +
+	"30313233343536373839" +
+	"40414243444546474849" +
+	"50515253545556575859" +
+	"60616263646566676869" +
+	"70717273747576777879" +
+	"80818283848586878889" +
+	"90919293949596979899"
+
+// pow10Uint64 holds the powers of ten that fit into a uint64.
+var pow10Uint64 = [20]uint64{
+	1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000, 1000000000,
+	10000000000, 100000000000, 1000000000000, 10000000000000, 100000000000000,
+	1000000000000000, 10000000000000000, 100000000000000000, 1000000000000000000,
+	10000000000000000000,
+}
+
+// pow10 sets z to 10^n, for 0 <= n <= 76, and returns z.
+func (z *Int) pow10(n int) *Int {
+	z.SetUint64(pow10Uint64[n%19])
+	if n >= 19 {
+		z.Mul(z, multipliers[n/19])
+	}
+	return z
+}
+
 func FromBig(b *big.Int) (*Int, error) {
 	var z Int
 	if overflow := z.SetFromBig(b); overflow {
@@ -58,112 +94,71 @@ func MustFromDec(decimal string) *Int {
 }
 
 func (z *Int) Dec() string {
-	s := z.Sign()
-	if s == 0 {
-		return "0"
-	}
+	var buf [len(maxAbsI256Dec) + 1]byte
+	return string(z.AppendDec(buf[:0]))
+}
+
+// AppendDec appends the decimal representation of z, as generated by z.Dec, to
+// dst and returns the extended buffer. It does not allocate if dst has enough
+// capacity for the at most 78 bytes.
+func (z *Int) AppendDec(dst []byte) []byte {
 	if z.IsInt64() {
-		return strconv.FormatInt(z.Int64(), 10)
+		return strconv.AppendInt(dst, z.Int64(), 10)
 	}
-	y := new(Int)
-	if s > 0 {
-		y.Set(z)
-	} else {
+	var y Int
+	if z.IsNegative() {
+		dst = append(dst, '-')
 		y.Neg(z)
+	} else {
+		y.Set(z)
 	}
+	return y.appendDecUnsigned(dst)
+}
+
+// appendDecUnsigned appends the decimal digits of z, treated as unsigned.
+func (z *Int) appendDecUnsigned(dst []byte) []byte {
+	// split z into base 10^19 chunks, least significant first
 	var (
-		out     = []byte("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")
-		divisor = new(Int).SetUint64(10000000000000000000)
-		pos     = len(out)
-		buf     = make([]byte, 0, 19)
+		chunks [5]uint64
+		n      int
+		y      = *z
 	)
-
 	for {
-		var quot Int
-		rem := udivrem(quot[:], y[:], divisor)
-		y.Set(&quot)
-		buf = strconv.AppendUint(buf[:0], rem.Uint64(), 10)
-		copy(out[pos-len(buf):], buf)
+		var rem uint64
+		y[3], rem = udivrem2by1(0, y[3], tenPow19, reciprocalTenPow19)
+		y[2], rem = udivrem2by1(rem, y[2], tenPow19, reciprocalTenPow19)
+		y[1], rem = udivrem2by1(rem, y[1], tenPow19, reciprocalTenPow19)
+		y[0], rem = udivrem2by1(rem, y[0], tenPow19, reciprocalTenPow19)
+		chunks[n] = rem
+		n++
 		if y.IsZero() {
 			break
 		}
-		pos -= 19
 	}
 
-	var res string
-	if s < 0 {
-		res = "-"
+	dst = strconv.AppendUint(dst, chunks[n-1], 10)
+	for i := n - 2; i >= 0; i-- {
+		dst = appendDec19(dst, chunks[i])
 	}
-	res += string(out[pos-len(buf):])
-	return res
+	return dst
 }
 
-func (z *Int) SetFromDec(s string) error {
-	var isNeg bool
-	if len(s) > 0 && s[0] == '-' {
-		s = s[1:]
-		isNeg = true
-	}
-	if len(s) > 0 && s[0] == '0' {
-		var (
-			i int
-			c rune
-		)
-		for i, c = range s {
-			if c != '0' {
-				break
-			}
-		}
-		s = s[i:]
-	}
-	if len(s) > len(maxAbsI256Dec) ||
-		(len(s) == len(maxAbsI256Dec) && s > maxAbsI256Dec) ||
-		(s == maxAbsI256Dec && !isNeg) {
-		return ErrOverflow
-	}
-	if err := z.fromDecimal(s); err != nil {
-		return err
-	}
-	if isNeg {
-		z.Neg(z)
-	}
-	return nil
+// appendDec19 appends v < 10^19 as exactly 19 decimal digits.
+func appendDec19(dst []byte, v uint64) []byte {
+	var buf [19]byte
+	for i := 17; i > 0; i -= 2 {
+		q := v / 100
+		r := (v - q*100) * 2
+		buf[i], buf[i+1] = decPairs[r], decPairs[r+1]
+		v = q
+	}
+	buf[0] = byte('0' + v)
+	return append(dst, buf[:]...)
 }
 
-func (z *Int) fromDecimal(bs string) error {
-	z.Clear()
-	var (
-		num       uint64
-		err       error
-		remaining = len(bs)
-	)
-	if remaining == 0 {
-		return io.EOF
-	}
-	for i, mult := range multipliers {
-		if remaining <= 0 {
-			return nil
-		}
-		if remaining > 19 {
-			num, err = strconv.ParseUint(bs[remaining-19:remaining], 10, 64)
-		} else {
-			num, err = strconv.ParseUint(bs, 10, 64)
-		}
-		if err != nil {
-			return err
-		}
-		if i == 0 {
-			z.SetUint64(num)
-		} else {
-			base := new(Int).SetUint64(num)
-			z.Add(z, base.Mul(base, mult))
-		}
-		if remaining > 19 {
-			bs = bs[0 : remaining-19]
-		}
-		remaining -= 19
-	}
-	return nil
+// SetFromDec is like SetFromDecBytes.
+func (z *Int) SetFromDec(s string) error {
+	return z.SetFromDecBytes(stringBytes(s))
 }
 
 func (z *Int) SetFromBig(b *big.Int) bool {
@@ -236,20 +231,25 @@ func (z *Int) ToBig() *big.Int {
 }
 
 func (z *Int) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + z.Dec() + `"`), nil
+	b := append(make([]byte, 0, len(maxAbsI256Dec)+3), '"')
+	b = z.AppendDec(b)
+	return append(b, '"'), nil
 }
 
+// UnmarshalJSON accepts a quoted decimal string, a quoted "0x" hex string or a
+// bare JSON number. Numbers with a fraction or an exponent are accepted only if
+// their value is an exact integer.
 func (z *Int) UnmarshalJSON(b []byte) error {
-	if len(b) < 2 || b[0] != '"' || b[len(b)-1] != '"' {
-		return z.UnmarshalText(b)
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return z.setFromJSONString(b[1 : len(b)-1])
 	}
-	return z.SetFromDec(string(b[1 : len(b)-1]))
+	return z.setFromJSONNumber(b)
 }
 
 func (z *Int) MarshalText() ([]byte, error) {
-	return []byte(z.Dec()), nil
+	return z.AppendDec(make([]byte, 0, len(maxAbsI256Dec)+1)), nil
 }
 
 func (z *Int) UnmarshalText(input []byte) error {
-	return z.SetFromDec(string(input))
+	return z.SetFromDecBytes(input)
 }
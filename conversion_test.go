@@ -3,6 +3,8 @@ package int256
 import (
 	"encoding/json"
 	"math/big"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,6 +96,42 @@ func TestDec(t *testing.T) {
 	})
 }
 
+func TestAppendDec(t *testing.T) {
+	t.Run("1. should append to buffer", func(t *testing.T) {
+		buf := []byte("x=")
+		buf = MinI256.AppendDec(buf)
+		assert.Equal(t, "x=-57896044618658097711785492504343953926634992332820282019728792003956564819968", string(buf))
+		assert.Equal(t, "1"+strings.Repeat("0", 38), string(MustFromDec("1"+strings.Repeat("0", 38)).AppendDec(nil)))
+		assert.Equal(t, "10000000000000000001", string(MustFromDec("10000000000000000001").AppendDec(nil)))
+	})
+
+	t.Run("2. should match big.Int for random values", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < 1000; i++ {
+			// vary the magnitude so that every chunk count is covered
+			b := new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), uint(1+i%255)))
+			if i%2 == 1 {
+				b.Neg(b)
+			}
+			z := MustFromBig(b)
+			assert.Equal(t, b.String(), string(z.AppendDec(nil)))
+			assert.Equal(t, b.String(), z.Dec())
+		}
+	})
+
+	t.Run("3. should not allocate", func(t *testing.T) {
+		buf := make([]byte, 0, 78)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = MinI256.AppendDec(buf[:0])
+		})
+		assert.Equal(t, 0.0, allocs)
+		allocs = testing.AllocsPerRun(100, func() {
+			_ = MaxI256.Dec()
+		})
+		assert.Equal(t, 1.0, allocs)
+	})
+}
+
 func TestMustFromDec(t *testing.T) {
 	t.Run("1. should return correct result", func(t *testing.T) {
 		dec := "-1"
@@ -421,3 +459,9 @@ func TestUnmarshalJson(t *testing.T) {
 		assert.Equal(t, "-14214214", z.Dec())
 	})
 }
+
+func TestPow10(t *testing.T) {
+	for n := 0; n <= 76; n++ {
+		assert.Equal(t, "1"+strings.Repeat("0", n), new(Int).pow10(n).Dec())
+	}
+}
@@ -0,0 +1,124 @@
+package int256
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// ParseError records a failed conversion of a decimal number.
+type ParseError struct {
+	Input  string // the input
+	Offset int    // byte offset in Input at which the error was detected
+	Err    error  // ErrEmptyNumber, ErrSyntax or ErrOverflow
+}
+
+func (e *ParseError) Error() string {
+	return "int256: parsing " + strconv.Quote(e.Input) + " at offset " + strconv.Itoa(e.Offset) + ": " +
+		strings.TrimPrefix(e.Err.Error(), "int256: ")
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// SetFromDecBytes sets z to the value of the decimal number in b, which must
+// match -?[0-9]+. Leading zeros are allowed and "-0" is zero. On failure z is
+// left unchanged and the returned error is a *ParseError.
+func (z *Int) SetFromDecBytes(b []byte) error {
+	i := 0
+	neg := len(b) > 0 && b[0] == '-'
+	if neg {
+		i++
+	}
+	if i == len(b) {
+		return &ParseError{Input: string(b), Offset: i, Err: ErrEmptyNumber}
+	}
+	for i < len(b)-1 && b[i] == '0' {
+		i++
+	}
+
+	digits := b[i:]
+	if len(digits) > len(maxAbsI256Dec) {
+		if j := invalidDigit(digits); j >= 0 {
+			return &ParseError{Input: string(b), Offset: i + j, Err: ErrSyntax}
+		}
+		return &ParseError{Input: string(b), Offset: i, Err: ErrOverflow}
+	}
+
+	// accumulate chunks of up to 19 digits, the first one taking the remainder
+	var x Int
+	n := len(digits) % 19
+	if n == 0 {
+		n = 19
+	}
+	for off := 0; off < len(digits); off, n = off+n, 19 {
+		v, j := parseChunk(digits[off : off+n])
+		if j >= 0 {
+			return &ParseError{Input: string(b), Offset: i + off + j, Err: ErrSyntax}
+		}
+		// at most 77 digits cannot carry out of 256 bits
+		x.umulAdd(&x, pow10Uint64[n], v)
+	}
+
+	if x[3]>>63 != 0 && !(neg && x == *MinI256) {
+		return &ParseError{Input: string(b), Offset: i, Err: ErrOverflow}
+	}
+	if neg {
+		x.Neg(&x)
+	}
+	*z = x
+	return nil
+}
+
+// parseChunk returns the value of up to 19 decimal digits, or the index of
+// the first byte that is not a digit.
+func parseChunk(b []byte) (uint64, int) {
+	var v uint64
+	i := 0
+	for ; i+8 <= len(b); i += 8 {
+		w := binary.LittleEndian.Uint64(b[i:])
+		if !isEightDigits(w) {
+			return 0, i + invalidDigit(b[i:i+8])
+		}
+		v = v*100000000 + parseEightDigits(w)
+	}
+	for ; i < len(b); i++ {
+		d := b[i] - '0'
+		if d > 9 {
+			return 0, i
+		}
+		v = v*10 + uint64(d)
+	}
+	return v, -1
+}
+
+// isEightDigits reports whether all bytes of the little-endian word w are
+// ASCII digits: each byte must be 0x3X with X+6 not carrying into the high nibble.
+func isEightDigits(w uint64) bool {
+	return (w&0xf0f0f0f0f0f0f0f0)|((w+0x0606060606060606)&0xf0f0f0f0f0f0f0f0)>>4 == 0x3333333333333333
+}
+
+// parseEightDigits converts eight ASCII digits, loaded little-endian so that
+// the first digit is the lowest byte, to their value.
+func parseEightDigits(w uint64) uint64 {
+	w -= 0x3030303030303030
+	w = w*10 + w>>8
+	return ((w&0x000000ff000000ff)*(100+1000000<<32) + (w>>16&0x000000ff000000ff)*(1+10000<<32)) >> 32
+}
+
+// invalidDigit returns the index of the first byte of b that is not a digit, or -1.
+func invalidDigit(b []byte) int {
+	for i, c := range b {
+		if c < '0' || c > '9' {
+			return i
+		}
+	}
+	return -1
+}
+
+// stringBytes returns the bytes of s without copying; they must not be modified.
+func stringBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
@@ -0,0 +1,141 @@
+package int256
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFromDecBytes(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		tests := []struct {
+			in  string
+			out string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"-000", "0"},
+			{"7", "7"},
+			{"12345678", "12345678"},
+			{"-123456789", "-123456789"},
+			{"9999999999999999999", "9999999999999999999"},
+			{"10000000000000000000", "10000000000000000000"},
+			{"000000000000000000000000000000000000000000000000000000000000000000000000000000000042", "42"},
+			{"57896044618658097711785492504343953926634992332820282019728792003956564819967", MaxI256.Dec()},
+			{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", MinI256.Dec()},
+			{"-00057896044618658097711785492504343953926634992332820282019728792003956564819968", MinI256.Dec()},
+		}
+		for i, test := range tests {
+			var z Int
+			assert.Nil(t, z.SetFromDecBytes([]byte(test.in)), "test %d", i)
+			assert.Equal(t, test.out, z.Dec(), "test %d", i)
+		}
+	})
+
+	t.Run("2. should return parse error", func(t *testing.T) {
+		tests := []struct {
+			in     string
+			offset int
+			err    error
+		}{
+			{"", 0, ErrEmptyNumber},
+			{"-", 1, ErrEmptyNumber},
+			{"+1", 0, ErrSyntax},
+			{"--1", 1, ErrSyntax},
+			{"#123", 0, ErrSyntax},
+			{"123456789XLXX", 9, ErrSyntax},
+			{"12345678901234567890123x", 23, ErrSyntax},
+			{"1 ", 1, ErrSyntax},
+			{"1.0", 1, ErrSyntax},
+			{"1e3", 1, ErrSyntax},
+			{"0x10", 1, ErrSyntax},
+			{"12345:78", 5, ErrSyntax},
+			{"12345/78", 5, ErrSyntax},
+			{"57896044618658097711785492504343953926634992332820282019728792003956564819968", 0, ErrOverflow},
+			{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", 1, ErrOverflow},
+			{"-0099999999999999999999999999999999999999999999999999999999999999999999999999999", 3, ErrOverflow},
+			{"1" + strings.Repeat("0", 77), 0, ErrOverflow},
+			{"1" + strings.Repeat("0", 100) + "a", 101, ErrSyntax},
+		}
+		for i, test := range tests {
+			z := NewInt(5)
+			err := z.SetFromDecBytes([]byte(test.in))
+			var perr *ParseError
+			assert.True(t, errors.As(err, &perr), "test %d", i)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+			assert.Equal(t, test.in, perr.Input, "test %d", i)
+			assert.Equal(t, test.offset, perr.Offset, "test %d", i)
+			assert.Equal(t, NewInt(5), z, "test %d: should leave z unchanged", i)
+		}
+	})
+
+	t.Run("3. should format error", func(t *testing.T) {
+		err := new(Int).SetFromDec("12a")
+		assert.EqualError(t, err, `int256: parsing "12a" at offset 2: invalid syntax`)
+	})
+
+	t.Run("4. should not allocate", func(t *testing.T) {
+		var z Int
+		b := []byte("-57896044618658097711785492504343953926634992332820282019728792003956564819968")
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = z.UnmarshalText(b)
+		})
+		assert.Equal(t, 0.0, allocs)
+		allocs = testing.AllocsPerRun(100, func() {
+			_ = z.SetFromDec("123456789012345678901234567890")
+		})
+		assert.Equal(t, 0.0, allocs)
+	})
+}
+
+func TestParseEightDigits(t *testing.T) {
+	for _, s := range []string{"00000000", "12345678", "99999999", "10000001"} {
+		w := uint64(0)
+		for i := 7; i >= 0; i-- {
+			w = w<<8 | uint64(s[i])
+		}
+		assert.True(t, isEightDigits(w), s)
+		v, _ := new(big.Int).SetString(s, 10)
+		assert.Equal(t, v.Uint64(), parseEightDigits(w), s)
+	}
+	for _, c := range []byte{'/', ':', 0, 0x80, 0xb0, 0xff, ' '} {
+		w := uint64(0x3030303030303030)&^(0xff<<24) | uint64(c)<<24
+		assert.False(t, isEightDigits(w), "byte 0x%02x", c)
+	}
+}
+
+func FuzzSetFromDecBytes(f *testing.F) {
+	for _, s := range []string{"0", "-0", "", "-", "123", "-9223372036854775808", MaxI256.Dec(), MinI256.Dec(), "1" + strings.Repeat("0", 77), "12a", "0x1"} {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var z Int
+		err := z.SetFromDecBytes(b)
+
+		s := string(b)
+		valid := len(strings.TrimPrefix(s, "-")) > 0 && invalidDigit([]byte(strings.TrimPrefix(s, "-"))) < 0
+		if !valid {
+			if err == nil {
+				t.Fatalf("accepted %q", s)
+			}
+			return
+		}
+		expected, _ := new(big.Int).SetString(s, 10)
+		var x Int
+		if overflow := x.SetFromBig(expected); overflow {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("%q: expected overflow, got %v", s, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+		if z != x {
+			t.Fatalf("%q: got %s", s, z.Dec())
+		}
+	})
+}
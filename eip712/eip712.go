@@ -0,0 +1,128 @@
+// Package eip712 encodes signed integer fields of EIP-712 typed data.
+//
+// encodeData(s) is typeHash(s) followed by the 32-byte encoding of each member.
+// For intN members that encoding is the ABI word of the value, sign-extended
+// to 256 bits; this package produces those words and leaves hashing to the caller.
+package eip712
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/KyberNetwork/int256"
+	"github.com/KyberNetwork/int256/abi"
+)
+
+var ErrInvalidType = errors.New("eip712: not a signed integer type")
+
+// ParseIntType returns N for the EIP-712 type name "intN"; "int" is an alias of "int256".
+func ParseIntType(typ string) (int, error) {
+	if !strings.HasPrefix(typ, "int") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidType, typ)
+	}
+	if typ == "int" {
+		return 256, nil
+	}
+	bits, err := strconv.Atoi(typ[3:])
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 || strconv.Itoa(bits) != typ[3:] {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidType, typ)
+	}
+	return bits, nil
+}
+
+// EncodeInt returns the encodeData word of value as a member of type typ,
+// which must be one of int8, int16, ..., int256.
+//
+// value may be an *int256.Int or int256.Int, a Go integer, a json.Number, an
+// integral float64 no larger than 2^53 in magnitude, or a string holding a
+// decimal or "0x" prefixed hex number with an optional minus sign, as found in
+// JSON typed-data messages. Values outside the range of typ are rejected.
+func EncodeInt(typ string, value any) ([32]byte, error) {
+	bits, err := ParseIntType(typ)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	x, err := toInt(value)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("eip712: invalid %s value: %w", typ, err)
+	}
+	word, err := abi.EncodeInt(x, bits)
+	if err != nil {
+		return word, fmt.Errorf("eip712: value %s does not fit %s: %w", x.Dec(), typ, err)
+	}
+	return word, nil
+}
+
+// AppendInt appends the encodeData word of value as a member of type typ to buf.
+func AppendInt(buf []byte, typ string, value any) ([]byte, error) {
+	word, err := EncodeInt(typ, value)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, word[:]...), nil
+}
+
+func toInt(value any) (*int256.Int, error) {
+	switch v := value.(type) {
+	case *int256.Int:
+		if v == nil {
+			return nil, errors.New("nil *int256.Int")
+		}
+		return v, nil
+	case int256.Int:
+		return &v, nil
+	case string:
+		return parseString(v)
+	case json.Number:
+		var z int256.Int
+		if err := z.UnmarshalJSON([]byte(v)); err != nil {
+			return nil, fmt.Errorf("json number %q: %w", v.String(), err)
+		}
+		return &z, nil
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > 1<<53 {
+			return nil, fmt.Errorf("float64 %v is not an exactly representable integer, decode JSON with UseNumber", v)
+		}
+		return int256.NewInt(int64(v)), nil
+	case int:
+		return int256.NewInt(int64(v)), nil
+	case int8:
+		return int256.NewInt(int64(v)), nil
+	case int16:
+		return int256.NewInt(int64(v)), nil
+	case int32:
+		return int256.NewInt(int64(v)), nil
+	case int64:
+		return int256.NewInt(v), nil
+	case uint:
+		return new(int256.Int).SetUint64(uint64(v)), nil
+	case uint8:
+		return new(int256.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(int256.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(int256.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(int256.Int).SetUint64(v), nil
+	}
+	return nil, fmt.Errorf("unsupported type %T", value)
+}
+
+func parseString(s string) (*int256.Int, error) {
+	var z int256.Int
+	digits := strings.TrimPrefix(s, "-")
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		if err := z.SetFromHex(s); err != nil {
+			return nil, fmt.Errorf("hex string %q: %w", s, err)
+		}
+		return &z, nil
+	}
+	if err := z.SetFromDec(s); err != nil {
+		return nil, fmt.Errorf("decimal string %q: %w", s, err)
+	}
+	return &z, nil
+}
@@ -0,0 +1,94 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/KyberNetwork/int256"
+	"github.com/KyberNetwork/int256/abi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIntType(t *testing.T) {
+	tests := []struct {
+		typ  string
+		bits int
+	}{
+		{"int", 256},
+		{"int8", 8},
+		{"int64", 64},
+		{"int256", 256},
+	}
+	for i, test := range tests {
+		bits, err := ParseIntType(test.typ)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.bits, bits, "test %d", i)
+	}
+
+	for i, typ := range []string{"uint8", "int0", "int7", "int264", "int08", "int+8", "int 8", "bytes32"} {
+		_, err := ParseIntType(typ)
+		assert.ErrorIs(t, err, ErrInvalidType, "test %d", i)
+	}
+}
+
+func TestEncodeInt(t *testing.T) {
+	minusOne := strings.Repeat("ff", 32)
+	tests := []struct {
+		typ   string
+		value any
+		word  string
+	}{
+		{"int8", "-1", minusOne},
+		{"int8", "-0x1", minusOne},
+		{"int16", "0x7fff", strings.Repeat("00", 30) + "7fff"},
+		{"int256", int256.NewInt(-1), minusOne},
+		{"int256", *int256.NewInt(-1), minusOne},
+		{"int32", json.Number("-1"), minusOne},
+		{"int32", json.Number("1e3"), strings.Repeat("00", 30) + "03e8"},
+		{"int64", float64(-1), minusOne},
+		{"int64", int64(-1), minusOne},
+		{"int64", uint8(255), strings.Repeat("00", 31) + "ff"},
+		{"int", "-57896044618658097711785492504343953926634992332820282019728792003956564819968", "80" + strings.Repeat("00", 31)},
+	}
+	for i, test := range tests {
+		word, err := EncodeInt(test.typ, test.value)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.word, hex.EncodeToString(word[:]), "test %d", i)
+
+		buf, err := AppendInt([]byte{1}, test.typ, test.value)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, append([]byte{1}, word[:]...), buf, "test %d", i)
+	}
+}
+
+func TestEncodeIntError(t *testing.T) {
+	t.Run("1. should return error out of range", func(t *testing.T) {
+		_, err := EncodeInt("int8", "128")
+		assert.ErrorIs(t, err, abi.ErrOutOfRange)
+		assert.EqualError(t, err, "eip712: value 128 does not fit int8: abi: value out of range for intN")
+	})
+
+	t.Run("2. should return error out of range", func(t *testing.T) {
+		_, err := EncodeInt("int64", "-0x8000000000000001")
+		assert.ErrorIs(t, err, abi.ErrOutOfRange)
+	})
+
+	t.Run("3. should return error invalid value", func(t *testing.T) {
+		for i, value := range []any{"1.5", "0xzz", "", json.Number("1.5"), json.Number("1e9223372036854775807"), json.Number("1e-9223372036854775808"), float64(0.5), float64(1 << 60), true, (*int256.Int)(nil)} {
+			_, err := EncodeInt("int256", value)
+			assert.Error(t, err, "test %d", i)
+		}
+	})
+
+	t.Run("4. should return error overflow", func(t *testing.T) {
+		_, err := EncodeInt("int256", "57896044618658097711785492504343953926634992332820282019728792003956564819968")
+		assert.ErrorIs(t, err, int256.ErrOverflow)
+	})
+
+	t.Run("5. should return error invalid type", func(t *testing.T) {
+		_, err := EncodeInt("uint256", "1")
+		assert.ErrorIs(t, err, ErrInvalidType)
+	})
+}
@@ -0,0 +1,139 @@
+package int256
+
+import (
+	"errors"
+	"strings"
+)
+
+// Ethereum JSON-RPC encodes integers in two ways:
+//
+//   - QUANTITY: "0x" followed by the minimal hex digits, as produced by geth's
+//     hexutil.Big ("0x0", "0x1f"). Negative values, which hexutil.Big cannot
+//     represent, use a "-0x" prefix ("-0x1f"), matching hexutil.EncodeBig.
+//   - DATA: a 32-byte two's complement word, "0x" followed by exactly 64 hex
+//     digits, as returned by eth_call for intN values and found in event data.
+
+var (
+	ErrMissingPrefix = errors.New("int256: hex string without 0x prefix")
+	ErrLeadingZero   = errors.New("int256: hex number with leading zero digits")
+	ErrWordLength    = errors.New("int256: hex data is not a whole number of 32-byte words")
+)
+
+func FromHexQuantity(s string) (*Int, error) {
+	var z Int
+	if err := z.SetFromHexQuantity(s); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// SetFromHexQuantity sets z from a QUANTITY string with the strict rules of
+// hexutil.Big: a "0x" prefix is required and leading zero digits are rejected.
+// A "-0x" prefix denotes a negative value.
+func (z *Int) SetFromHexQuantity(s string) error {
+	digits := strings.TrimPrefix(s, "-")
+	if len(digits) < 2 || digits[0] != '0' || (digits[1] != 'x' && digits[1] != 'X') {
+		return ErrMissingPrefix
+	}
+	digits = digits[2:]
+	switch {
+	case len(digits) == 0:
+		return ErrEmptyNumber
+	case len(digits) > 1 && digits[0] == '0':
+		return ErrLeadingZero
+	case digits == "0" && len(s) != len(digits)+2:
+		return ErrSyntax // "-0x0"
+	}
+	return z.SetFromHex(s)
+}
+
+func FromHexWord(s string) (*Int, error) {
+	var z Int
+	if err := z.SetFromHexWord(s); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// SetFromHexWord sets z from a DATA string holding exactly one 32-byte two's complement word.
+func (z *Int) SetFromHexWord(s string) error {
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return ErrMissingPrefix
+	}
+	if len(s) != 66 {
+		return ErrWordLength
+	}
+	return z.setHexWordDigits(s[2:])
+}
+
+// setHexWordDigits sets z from exactly 64 hex digits of a two's complement word.
+func (z *Int) setHexWordDigits(s string) error {
+	var x Int
+	for i := 0; i < 64; i++ {
+		nib, ok := hexNibble(s[63-i])
+		if !ok {
+			return ErrSyntax
+		}
+		x[i/16] |= uint64(nib) << (4 * uint(i%16))
+	}
+	z.Set(&x)
+	return nil
+}
+
+// DecodeHexWords decodes a DATA string made of consecutive 32-byte two's
+// complement words, such as the result of an eth_call returning several intN
+// values. The empty result "0x" decodes to an empty slice.
+func DecodeHexWords(data string) ([]Int, error) {
+	if len(data) < 2 || data[0] != '0' || (data[1] != 'x' && data[1] != 'X') {
+		return nil, ErrMissingPrefix
+	}
+	data = data[2:]
+	if len(data)%64 != 0 {
+		return nil, ErrWordLength
+	}
+	words := make([]Int, len(data)/64)
+	for i := range words {
+		if err := words[i].setHexWordDigits(data[64*i : 64*(i+1)]); err != nil {
+			return nil, err
+		}
+	}
+	return words, nil
+}
+
+// HexQuantity is an Int that marshals to a JSON-RPC QUANTITY string and
+// unmarshals with the strict rules of SetFromHexQuantity, like hexutil.Big.
+type HexQuantity Int
+
+func (z HexQuantity) MarshalText() ([]byte, error) {
+	return (*Int)(&z).AppendHex(make([]byte, 0, 67)), nil
+}
+
+func (z *HexQuantity) UnmarshalText(input []byte) error {
+	return (*Int)(z).SetFromHexQuantity(string(input))
+}
+
+func (z *HexQuantity) UnmarshalJSON(input []byte) error {
+	if len(input) < 2 || input[0] != '"' || input[len(input)-1] != '"' {
+		return ErrSyntax
+	}
+	return z.UnmarshalText(input[1 : len(input)-1])
+}
+
+// HexWord is an Int that marshals to a JSON-RPC DATA string holding one
+// 32-byte two's complement word, and unmarshals with SetFromHexWord.
+type HexWord Int
+
+func (z HexWord) MarshalText() ([]byte, error) {
+	return []byte((*Int)(&z).HexTwosComplement()), nil
+}
+
+func (z *HexWord) UnmarshalText(input []byte) error {
+	return (*Int)(z).SetFromHexWord(string(input))
+}
+
+func (z *HexWord) UnmarshalJSON(input []byte) error {
+	if len(input) < 2 || input[0] != '"' || input[len(input)-1] != '"' {
+		return ErrSyntax
+	}
+	return z.UnmarshalText(input[1 : len(input)-1])
+}
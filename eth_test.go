@@ -0,0 +1,111 @@
+package int256
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHexQuantity(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"0x0", "0"},
+		{"0x1", "1"},
+		{"0xdeadBEEF", "3735928559"},
+		{"-0x1f", "-31"},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000000", "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+	}
+	for i, test := range tests {
+		z, err := FromHexQuantity(test.in)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.out, z.Dec(), "test %d", i)
+		assert.Equal(t, strings.ToLower(test.in), z.Hex(), "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		errTests := []struct {
+			in  string
+			err error
+		}{
+			{"", ErrMissingPrefix},
+			{"1f", ErrMissingPrefix},
+			{"0x", ErrEmptyNumber},
+			{"0x01", ErrLeadingZero},
+			{"-0x0", ErrSyntax},
+			{"0xg", ErrSyntax},
+			{"0x8000000000000000000000000000000000000000000000000000000000000000", ErrOverflow},
+		}
+		for i, test := range errTests {
+			_, err := FromHexQuantity(test.in)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+	})
+}
+
+func TestFromHexWord(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		z, err := FromHexWord("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff85")
+		assert.Nil(t, err)
+		assert.Equal(t, "-123", z.Dec())
+		assert.Equal(t, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff85", z.HexTwosComplement())
+	})
+
+	t.Run("2. should return error", func(t *testing.T) {
+		_, err := FromHexWord("0x85")
+		assert.ErrorIs(t, err, ErrWordLength)
+		_, err = FromHexWord("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff85")
+		assert.ErrorIs(t, err, ErrMissingPrefix)
+		_, err = FromHexWord("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffz85")
+		assert.ErrorIs(t, err, ErrSyntax)
+	})
+}
+
+func TestDecodeHexWords(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		words, err := DecodeHexWords("0x" +
+			"000000000000000000000000000000000000000000000000000000000000002a" +
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd6")
+		assert.Nil(t, err)
+		assert.Equal(t, []Int{*NewInt(42), *NewInt(-42)}, words)
+	})
+
+	t.Run("2. should return empty result", func(t *testing.T) {
+		words, err := DecodeHexWords("0x")
+		assert.Nil(t, err)
+		assert.Empty(t, words)
+	})
+
+	t.Run("3. should return error", func(t *testing.T) {
+		_, err := DecodeHexWords("0x00")
+		assert.ErrorIs(t, err, ErrWordLength)
+	})
+}
+
+func TestHexQuantityJSON(t *testing.T) {
+	type tx struct {
+		Value HexQuantity  `json:"value"`
+		Delta *HexQuantity `json:"delta"`
+		Data  HexWord      `json:"data"`
+	}
+	in := tx{
+		Value: HexQuantity(*NewInt(0)),
+		Delta: (*HexQuantity)(NewInt(-256)),
+		Data:  HexWord(*NewInt(-1)),
+	}
+	b, err := json.Marshal(in)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"value":"0x0","delta":"-0x100","data":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"}`, string(b))
+
+	var out tx
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"value":"0x0100"}`), &out))
+	assert.Error(t, json.Unmarshal([]byte(`{"value":256}`), &out))
+	assert.Error(t, json.Unmarshal([]byte(`{"data":"0x01"}`), &out))
+}
@@ -0,0 +1,221 @@
+package int256
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+var errInvalidScanVerb = errors.New("int256: Int.Scan: invalid verb")
+
+// String returns the decimal representation of z.
+func (z *Int) String() string {
+	if z == nil {
+		return "<nil>"
+	}
+	return z.Dec()
+}
+
+// GoString returns a Go expression that evaluates to z, for use with the %#v verb.
+func (z *Int) GoString() string {
+	if z == nil {
+		return "(*int256.Int)(nil)"
+	}
+	return `int256.MustFromDec("` + z.Dec() + `")`
+}
+
+// Format implements fmt.Formatter in the same way as big.Int. It accepts the
+// verbs 'b' (binary), 'o' and 'O' (octal), 'd', 's' and 'v' (decimal), 'x' and
+// 'X' (hexadecimal), the flags '+', ' ', '#', '0' and '-', width and precision.
+// The %#v verb prints z.GoString().
+func (z *Int) Format(s fmt.State, ch rune) {
+	if ch == 'v' && s.Flag('#') {
+		fmt.Fprint(s, z.GoString())
+		return
+	}
+
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o', 'O':
+		base = 8
+	case 'd', 's', 'v':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	default:
+		fmt.Fprintf(s, "%%!%c(int256.Int=%s)", ch, z.String())
+		return
+	}
+
+	if z == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+
+	x := *z
+	sign := ""
+	switch {
+	case x.IsNegative():
+		sign = "-"
+		x.Neg(&x)
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b':
+			prefix = "0b"
+		case 'o':
+			prefix = "0"
+		case 'x':
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	var buf [256]byte
+	digits := x.appendUnsigned(buf[:0], base)
+	if ch == 'X' {
+		for i, d := range digits {
+			if 'a' <= d && d <= 'z' {
+				digits[i] = 'A' + (d - 'a')
+			}
+		}
+	}
+
+	var (
+		left  int // space characters to the left of the digits for right justification ("%8d")
+		zeros int // zero characters as left-most digits ("%.8d")
+		right int // space characters to the right of the digits for left justification ("%-8d")
+	)
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits)
+		case len(digits) == 1 && digits[0] == '0' && precision == 0:
+			return // print nothing for a zero value with zero precision ("%.0d")
+		}
+	}
+
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width {
+		switch d := width - length; {
+		case s.Flag('-'):
+			right = d
+		case s.Flag('0') && !precisionSet:
+			zeros = d
+		default:
+			left = d
+		}
+	}
+
+	writeMultiple(s, " ", left)
+	writeMultiple(s, sign, 1)
+	writeMultiple(s, prefix, 1)
+	writeMultiple(s, "0", zeros)
+	_, _ = s.Write(digits)
+	writeMultiple(s, " ", right)
+}
+
+func writeMultiple(s fmt.State, text string, count int) {
+	if len(text) > 0 {
+		b := []byte(text)
+		for ; count > 0; count-- {
+			_, _ = s.Write(b)
+		}
+	}
+}
+
+// Scan implements fmt.Scanner in the same way as big.Int. It accepts the verbs
+// 'b' (binary), 'o' (octal), 'd' (decimal), 'x' and 'X' (hexadecimal), and 's'
+// and 'v', which detect the base from a "0b", "0o", "0x" or "0" prefix.
+func (z *Int) Scan(s fmt.ScanState, ch rune) error {
+	s.SkipSpace()
+	base := 0
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o':
+		base = 8
+	case 'd':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	case 's', 'v':
+	default:
+		return errInvalidScanVerb
+	}
+	tok, err := scanToken(s, base)
+	if err != nil {
+		return err
+	}
+	return z.setString(tok, base)
+}
+
+// scanToken reads the longest prefix of s that can form a number in the given
+// base, including the sign and, for base 0, the base prefix and underscores.
+func scanToken(s fmt.ScanState, base int) (string, error) {
+	var buf []byte
+	r, _, err := s.ReadRune()
+	if err != nil {
+		return "", err
+	}
+	if r == '+' || r == '-' {
+		buf = append(buf, byte(r))
+		if r, _, err = s.ReadRune(); err != nil {
+			return "", err
+		}
+	}
+
+	underscoreOk := base == 0
+	if base == 0 {
+		base = 10
+		if r == '0' {
+			base = 8
+			buf = append(buf, '0')
+			if r, _, err = s.ReadRune(); err == io.EOF {
+				return string(buf), nil
+			}
+			switch r {
+			case 'x', 'X':
+				base = 16
+			case 'o', 'O':
+				base = 8
+			case 'b', 'B':
+				base = 2
+			}
+			if base != 8 || r == 'o' || r == 'O' {
+				buf = append(buf, byte(r))
+				r, _, err = s.ReadRune()
+			}
+		}
+	}
+
+	for ; err == nil; r, _, err = s.ReadRune() {
+		if r >= utf8.RuneSelf {
+			_ = s.UnreadRune()
+			break
+		}
+		if _, ok := digitValue(byte(r), base); !ok && !(underscoreOk && r == '_') {
+			_ = s.UnreadRune()
+			break
+		}
+		buf = append(buf, byte(r))
+	}
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return string(buf), nil
+}
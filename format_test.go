@@ -0,0 +1,118 @@
+package int256
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "-141243", MustFromDec("-141243").String())
+	})
+
+	t.Run("2. should return correct result", func(t *testing.T) {
+		var z *Int
+		assert.Equal(t, "<nil>", z.String())
+	})
+}
+
+func TestGoString(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		assert.Equal(t, `int256.MustFromDec("-42")`, fmt.Sprintf("%#v", NewInt(-42)))
+	})
+}
+
+func TestFormat(t *testing.T) {
+	formats := []string{
+		"%v", "%d", "%s", "%b", "%o", "%O", "%x", "%X",
+		"%+d", "% d", "%#x", "%#X", "%#o", "%#b", "%+#x",
+		"%10d", "%-10d|", "%010d", "%+010d", "%.5d", "%8.5d", "%.0d", "%-#12x|",
+		"%q",
+	}
+	values := []string{
+		"0", "1", "-1", "255", "-255", "123456789",
+		"57896044618658097711785492504343953926634992332820282019728792003956564819967",
+		"-57896044618658097711785492504343953926634992332820282019728792003956564819968",
+	}
+	for _, v := range values {
+		z := MustFromDec(v)
+		b, _ := new(big.Int).SetString(v, 10)
+		for _, f := range formats {
+			expected := fmt.Sprintf(f, b)
+			if f == "%q" {
+				expected = "%!q(int256.Int=" + v + ")"
+			}
+			assert.Equal(t, expected, fmt.Sprintf(f, z), "format %q value %s", f, v)
+		}
+	}
+
+	t.Run("nil value", func(t *testing.T) {
+		var z *Int
+		assert.Equal(t, "<nil>", fmt.Sprintf("%d", z))
+	})
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		out    string
+	}{
+		{"%d", "12345", "12345"},
+		{"%d", "  -12345", "-12345"},
+		{"%v", "0x_ff", "255"},
+		{"%v", "-0b101", "-5"},
+		{"%v", "0o17", "15"},
+		{"%v", "017", "15"},
+		{"%v", "0", "0"},
+		{"%s", "+1_000", "1000"},
+		{"%x", "-FF", "-255"},
+		{"%X", "ff", "255"},
+		{"%o", "17", "15"},
+		{"%b", "101", "5"},
+		{"%d", "-57896044618658097711785492504343953926634992332820282019728792003956564819968", "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+	}
+	for i, test := range tests {
+		z := new(Int)
+		_, err := fmt.Sscanf(test.input, test.format, z)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.out, z.Dec(), "test %d", i)
+	}
+
+	t.Run("should scan several values", func(t *testing.T) {
+		var (
+			x, y Int
+			s    string
+		)
+		n, err := fmt.Sscan("0x10 -42 rest", &x, &y, &s)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, "16", x.Dec())
+		assert.Equal(t, "-42", y.Dec())
+		assert.Equal(t, "rest", s)
+	})
+
+	t.Run("should stop at first invalid digit", func(t *testing.T) {
+		var (
+			x Int
+			s string
+		)
+		_, err := fmt.Sscanf("123abc", "%d%s", &x, &s)
+		assert.Nil(t, err)
+		assert.Equal(t, "123", x.Dec())
+		assert.Equal(t, "abc", s)
+	})
+
+	t.Run("should return error", func(t *testing.T) {
+		var x Int
+		_, err := fmt.Sscanf("57896044618658097711785492504343953926634992332820282019728792003956564819968", "%d", &x)
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = fmt.Sscanf("-", "%d", &x)
+		assert.Error(t, err)
+		_, err = fmt.Sscanf("12", "%q", &x)
+		assert.Error(t, err)
+	})
+}
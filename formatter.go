@@ -0,0 +1,273 @@
+package int256
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Grouping selects how the digits of the integer part are grouped.
+type Grouping byte
+
+const (
+	GroupNone      Grouping = iota // 1234567
+	GroupThousands                 // 1,234,567
+	GroupIndian                    // 12,34,567
+)
+
+// Notation selects how a Formatter renders a value.
+type Notation byte
+
+const (
+	NotationStandard   Notation = iota // 1234567.89
+	NotationCompact                    // 1.23M
+	NotationScientific                 // 1.2346e+06
+)
+
+var defaultCompactSuffixes = []string{"", "K", "M", "B", "T"}
+
+// Formatter renders an Int for humans. All computations are done on the exact
+// decimal digits, so no precision is lost for values beyond the range of a
+// float64. The zero value formats like Dec.
+type Formatter struct {
+	// Decimals scales the value down by 10^Decimals before formatting, as in
+	// FormatUnits. It must not exceed MaxDecimals.
+	Decimals int
+
+	Notation Notation
+	Grouping Grouping
+
+	// DecimalSeparator and GroupSeparator default to "." and ",".
+	DecimalSeparator string
+	GroupSeparator   string
+
+	// CompactSuffixes are the suffixes for 10^0, 10^3, 10^6, ... in compact
+	// notation. It defaults to "", "K", "M", "B", "T".
+	CompactSuffixes []string
+
+	// SignificantDigits limits the number of significant digits if positive.
+	SignificantDigits int
+
+	// MaxFractionDigits limits the number of fraction digits if positive and
+	// rounds to an integer if negative. Zero means no limit; compact notation
+	// then defaults to 3 significant digits unless SignificantDigits is set.
+	MaxFractionDigits int
+
+	// MinFractionDigits pads the fraction with zeros to at least this many digits.
+	MinFractionDigits int
+
+	// Rounding is the mode used when digits are dropped.
+	Rounding RoundingMode
+}
+
+// Format returns the formatted value of z.
+func (f Formatter) Format(z *Int) string {
+	return string(f.Append(nil, z))
+}
+
+// Append appends the formatted value of z to dst. It panics if f.Decimals is
+// not between 0 and MaxDecimals or f.Rounding is not a defined mode.
+func (f Formatter) Append(dst []byte, z *Int) []byte {
+	if f.Decimals < 0 || f.Decimals > MaxDecimals {
+		panic("int256: decimals out of range")
+	}
+	if !f.Rounding.valid() {
+		panic("int256: invalid rounding mode")
+	}
+	x := newDecimal(z, f.Decimals)
+	switch f.Notation {
+	case NotationCompact:
+		return f.appendCompact(dst, x)
+	case NotationScientific:
+		return f.appendScientific(dst, x)
+	}
+	if f.SignificantDigits > 0 {
+		x.round(f.SignificantDigits, f.Rounding)
+	}
+	f.roundFraction(&x)
+	return f.appendFixed(dst, x)
+}
+
+func (f Formatter) appendCompact(dst []byte, x decimal) []byte {
+	suffixes := f.CompactSuffixes
+	if len(suffixes) == 0 {
+		suffixes = defaultCompactSuffixes
+	}
+	sig := f.SignificantDigits
+	if sig == 0 && f.MaxFractionDigits == 0 {
+		sig = 3
+	}
+	if sig > 0 {
+		x.round(sig, f.Rounding)
+	}
+	k := compactIndex(x.exp, len(suffixes))
+	x.exp -= 3 * k
+	f.roundFraction(&x)
+	// rounding may carry into the next power of 1000, e.g. 999.9K to 1000K
+	if k2 := compactIndex(x.exp+3*k, len(suffixes)); k2 != k {
+		x.exp += 3 * (k - k2)
+		k = k2
+	}
+	return append(f.appendFixed(dst, x), suffixes[k]...)
+}
+
+func (f Formatter) appendScientific(dst []byte, x decimal) []byte {
+	if f.SignificantDigits > 0 {
+		x.round(f.SignificantDigits, f.Rounding)
+	}
+	if f.MaxFractionDigits != 0 {
+		x.round(1+min(max(f.MaxFractionDigits, 0), len(x.d)), f.Rounding)
+	}
+	exp := 0
+	if len(x.d) > 0 {
+		exp = x.exp - 1
+		x.exp = 1
+	}
+	dst = f.appendFixed(dst, x)
+	dst = append(dst, 'e')
+	if exp < 0 {
+		dst = append(dst, '-')
+		exp = -exp
+	} else {
+		dst = append(dst, '+')
+	}
+	if exp < 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(exp), 10)
+}
+
+func (f Formatter) roundFraction(x *decimal) {
+	// a limit at or beyond the last digit is a no-op; checking first keeps exp+limit from overflowing
+	if f.MaxFractionDigits != 0 && f.MaxFractionDigits < len(x.d)-x.exp {
+		x.round(x.exp+max(f.MaxFractionDigits, 0), f.Rounding)
+	}
+}
+
+// appendFixed appends x in positional notation.
+func (f Formatter) appendFixed(dst []byte, x decimal) []byte {
+	if x.neg && len(x.d) > 0 {
+		dst = append(dst, '-')
+	}
+
+	var intPart, frac string
+	switch {
+	case x.exp <= 0:
+		intPart = "0"
+		frac = strings.Repeat("0", -x.exp) + string(x.d)
+	case x.exp >= len(x.d):
+		intPart = string(x.d) + strings.Repeat("0", x.exp-len(x.d))
+	default:
+		intPart, frac = string(x.d[:x.exp]), string(x.d[x.exp:])
+	}
+	if len(x.d) == 0 {
+		frac = ""
+	}
+	if len(frac) < f.MinFractionDigits {
+		frac += strings.Repeat("0", f.MinFractionDigits-len(frac))
+	}
+
+	dst = f.appendGrouped(dst, intPart)
+	if len(frac) > 0 {
+		if f.DecimalSeparator == "" {
+			dst = append(dst, '.')
+		} else {
+			dst = append(dst, f.DecimalSeparator...)
+		}
+		dst = append(dst, frac...)
+	}
+	return dst
+}
+
+func (f Formatter) appendGrouped(dst []byte, digits string) []byte {
+	if f.Grouping == GroupNone {
+		return append(dst, digits...)
+	}
+	sep := f.GroupSeparator
+	if sep == "" {
+		sep = ","
+	}
+	for i := 0; i < len(digits); i++ {
+		if rem := len(digits) - i; i > 0 && f.groupsBefore(rem) {
+			dst = append(dst, sep...)
+		}
+		dst = append(dst, digits[i])
+	}
+	return dst
+}
+
+// groupsBefore reports whether a separator precedes the digit that has rem-1 digits after it.
+func (f Formatter) groupsBefore(rem int) bool {
+	if f.Grouping == GroupIndian {
+		return rem == 3 || (rem > 3 && (rem-3)%2 == 0)
+	}
+	return rem%3 == 0
+}
+
+// compactIndex returns the index of the compact suffix for a value with exp integer digits.
+func compactIndex(exp, n int) int {
+	if exp <= 3 {
+		return 0
+	}
+	return min((exp-1)/3, n-1)
+}
+
+// decimal is an exact decimal value 0.d * 10^exp.
+type decimal struct {
+	neg bool
+	d   []byte // digits without leading and trailing zeros; empty for zero
+	exp int
+}
+
+func newDecimal(z *Int, decimals int) decimal {
+	neg, digits := splitSign(z.Dec())
+	digits = strings.TrimLeft(digits, "0")
+	if len(digits) == 0 {
+		return decimal{}
+	}
+	return decimal{neg: neg, d: []byte(strings.TrimRight(digits, "0")), exp: len(digits) - decimals}
+}
+
+// round keeps the first n digits of x, which may be zero or negative to round
+// at a position before the first digit.
+func (x *decimal) round(n int, mode RoundingMode) {
+	if n >= len(x.d) {
+		return
+	}
+	half, last := -1, byte(0)
+	if n >= 0 {
+		half = compareHalf(string(x.d[n:]))
+	}
+	if n > 0 {
+		last = x.d[n-1] - '0'
+	}
+	away := roundsAway(mode, x.neg, last, half)
+
+	if n <= 0 {
+		if away {
+			// 10^(exp-n)
+			x.d = append(x.d[:0], '1')
+			x.exp += 1 - n
+		} else {
+			x.d, x.exp = x.d[:0], 0
+		}
+		return
+	}
+
+	x.d = x.d[:n]
+	if away {
+		i := n - 1
+		for i >= 0 && x.d[i] == '9' {
+			i--
+		}
+		if i < 0 {
+			x.d = append(x.d[:0], '1')
+			x.exp++
+			return
+		}
+		x.d[i]++
+		x.d = x.d[:i+1]
+	}
+	for len(x.d) > 0 && x.d[len(x.d)-1] == '0' {
+		x.d = x.d[:len(x.d)-1]
+	}
+}
@@ -0,0 +1,84 @@
+package int256
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter(t *testing.T) {
+	long := "1234567890123456789012345678901234567890123456789012345678901234567890123456"
+	tests := []struct {
+		f   Formatter
+		in  string
+		out string
+	}{
+		{Formatter{}, "0", "0"},
+		{Formatter{}, "-1234567", "-1234567"},
+		{Formatter{}, MinI256.Dec(), MinI256.Dec()},
+		{Formatter{Grouping: GroupThousands}, "1234567", "1,234,567"},
+		{Formatter{Grouping: GroupThousands}, "-123456", "-123,456"},
+		{Formatter{Grouping: GroupThousands}, "123", "123"},
+		{Formatter{Grouping: GroupIndian}, "1234567", "12,34,567"},
+		{Formatter{Grouping: GroupIndian}, "123456789", "12,34,56,789"},
+		{Formatter{Grouping: GroupIndian}, "1234", "1,234"},
+		{Formatter{Grouping: GroupThousands, GroupSeparator: ".", DecimalSeparator: ",", Decimals: 2}, "123456789", "1.234.567,89"},
+		{Formatter{Grouping: GroupThousands, GroupSeparator: " "}, "1234567", "1 234 567"},
+
+		{Formatter{Decimals: 18}, "1500000000000000000", "1.5"},
+		{Formatter{Decimals: 18}, "-1", "-0.000000000000000001"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 4}, "1234567890000000000", "1.2346"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 4, Rounding: RoundDown}, "1234567890000000000", "1.2345"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 2, MinFractionDigits: 2}, "1500000000000000000", "1.50"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 2}, "999999999999999999", "1"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 2}, "-4000000000000000", "0"},
+		{Formatter{Decimals: 18, MaxFractionDigits: 2, Rounding: RoundFloor}, "-4000000000000000", "-0.01"},
+		{Formatter{Decimals: 1, MaxFractionDigits: -1}, "25", "2"},
+		{Formatter{Decimals: 1, MaxFractionDigits: -1, Rounding: RoundHalfUp}, "25", "3"},
+		{Formatter{SignificantDigits: 3}, "123456", "123000"},
+		{Formatter{SignificantDigits: 3, Grouping: GroupThousands}, "-987654", "-988,000"},
+		{Formatter{MinFractionDigits: 2}, "7", "7.00"},
+
+		{Formatter{Notation: NotationCompact}, "0", "0"},
+		{Formatter{Notation: NotationCompact}, "999", "999"},
+		{Formatter{Notation: NotationCompact}, "1234", "1.23K"},
+		{Formatter{Notation: NotationCompact}, "1234567", "1.23M"},
+		{Formatter{Notation: NotationCompact}, "-1234567890", "-1.23B"},
+		{Formatter{Notation: NotationCompact}, "999999", "1M"},
+		{Formatter{Notation: NotationCompact}, "999499", "999K"},
+		{Formatter{Notation: NotationCompact, MaxFractionDigits: 1}, "999960", "1M"},
+		{Formatter{Notation: NotationCompact, MaxFractionDigits: 1}, "1250000", "1.2M"},
+		{Formatter{Notation: NotationCompact, Decimals: 18}, "1500000000000000000000", "1.5K"},
+		{Formatter{Notation: NotationCompact, Grouping: GroupThousands}, long, "1,230,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000,000T"},
+		{Formatter{Notation: NotationCompact, CompactSuffixes: []string{"", " thousand", " million"}}, "2500000", "2.5 million"},
+		{Formatter{Notation: NotationCompact, CompactSuffixes: []string{"", "k"}}, "2500000", "2500k"},
+
+		{Formatter{Notation: NotationScientific}, "0", "0e+00"},
+		{Formatter{Notation: NotationScientific}, "1000", "1e+03"},
+		{Formatter{Notation: NotationScientific, SignificantDigits: 5}, "1234567890123456789012345", "1.2346e+24"},
+		{Formatter{Notation: NotationScientific, MaxFractionDigits: 2}, "-99999", "-1e+05"},
+		{Formatter{Notation: NotationScientific, Decimals: 18}, "15", "1.5e-17"},
+		{Formatter{Notation: NotationScientific, SignificantDigits: 3, MinFractionDigits: 2}, "100", "1.00e+02"},
+		{Formatter{Notation: NotationScientific}, long, "1.234567890123456789012345678901234567890123456789012345678901234567890123456e+75"},
+		{Formatter{Notation: NotationScientific, SignificantDigits: 2, DecimalSeparator: ","}, MaxI256.Dec(), "5,8e+76"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test.out, test.f.Format(MustFromDec(test.in)), "test %d", i)
+	}
+
+	t.Run("should append", func(t *testing.T) {
+		assert.Equal(t, "x=1,000", string(Formatter{Grouping: GroupThousands}.Append([]byte("x="), NewInt(1000))))
+	})
+
+	t.Run("should panic on invalid options", func(t *testing.T) {
+		assert.Panics(t, func() { Formatter{Decimals: -1}.Format(NewInt(1)) })
+		assert.Panics(t, func() { Formatter{Decimals: MaxDecimals + 1}.Format(NewInt(1)) })
+		assert.Panics(t, func() { Formatter{Rounding: 42}.Format(NewInt(1)) })
+	})
+
+	t.Run("should not overflow with large fraction limits", func(t *testing.T) {
+		assert.Equal(t, "1.5", Formatter{Decimals: 1, MaxFractionDigits: math.MaxInt}.Format(NewInt(15)))
+		assert.Equal(t, "1.5e+00", Formatter{Decimals: 1, MaxFractionDigits: math.MaxInt, Notation: NotationScientific}.Format(NewInt(15)))
+	})
+}
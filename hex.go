@@ -0,0 +1,104 @@
+package int256
+
+const hexDigits = "0123456789abcdef"
+
+func FromHex(hex string) (*Int, error) {
+	var z Int
+	if err := z.SetFromHex(hex); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+func MustFromHex(hex string) *Int {
+	z, err := FromHex(hex)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// SetFromHex sets z to the value of the signed hexadecimal string s.
+// The digits may be preceded by "-" and by a "0x" or "0X" prefix, may contain
+// leading zeros and may mix upper and lower case. On error z is left unchanged.
+func (z *Int) SetFromHex(s string) error {
+	var isNeg bool
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+		isNeg = true
+	}
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+	if len(s) == 0 {
+		return ErrEmptyNumber
+	}
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+
+	var x Int
+	for i := 0; i < len(s); i++ {
+		nib, ok := hexNibble(s[len(s)-1-i])
+		if !ok {
+			return ErrSyntax
+		}
+		if i < 64 {
+			x[i/16] |= uint64(nib) << (4 * uint(i%16))
+		}
+	}
+	if len(s) > 64 || (x.IsNegative() && !(isNeg && x.IsMinI256())) {
+		return ErrOverflow
+	}
+
+	z.Set(&x)
+	if isNeg {
+		z.Neg(z)
+	}
+	return nil
+}
+
+func hexNibble(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// Hex returns the minimal signed hexadecimal form of z, e.g. "0x0", "0x1f" or "-0x1f".
+func (z *Int) Hex() string {
+	return string(z.AppendHex(make([]byte, 0, 67)))
+}
+
+// AppendHex appends the minimal signed hexadecimal form of z to dst and returns the extended buffer.
+func (z *Int) AppendHex(dst []byte) []byte {
+	x := *z
+	if x.IsNegative() {
+		dst = append(dst, '-')
+		x.Neg(&x)
+	}
+	dst = append(dst, '0', 'x')
+	if x.IsZero() {
+		return append(dst, '0')
+	}
+	for i := (x.BitLen()+3)/4 - 1; i >= 0; i-- {
+		dst = append(dst, hexDigits[(x[i/16]>>(4*uint(i%16)))&0xf])
+	}
+	return dst
+}
+
+// HexTwosComplement returns the 256-bit two's complement representation of z
+// as "0x" followed by exactly 64 lower case hex digits.
+func (z *Int) HexTwosComplement() string {
+	var out [66]byte
+	out[0], out[1] = '0', 'x'
+	for i := 0; i < 64; i++ {
+		out[65-i] = hexDigits[(z[i/16]>>(4*uint(i%16)))&0xf]
+	}
+	return string(out[:])
+}
@@ -0,0 +1,134 @@
+package int256
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHex(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		z, err := FromHex("0x8727f5e0686e2a46fd1c06d19b46fb9323a6d889d")
+		assert.Nil(t, err)
+		assert.Equal(t, "12345678431937219573219471295439254379564372953245", z.Dec())
+	})
+
+	t.Run("2. should return correct result", func(t *testing.T) {
+		z, err := FromHex("-0X00000000ABCdef")
+		assert.Nil(t, err)
+		assert.Equal(t, "-11259375", z.Dec())
+	})
+
+	t.Run("3. should return correct result", func(t *testing.T) {
+		z, err := FromHex("ff")
+		assert.Nil(t, err)
+		assert.Equal(t, "255", z.Dec())
+	})
+
+	t.Run("4. should return correct result", func(t *testing.T) {
+		z, err := FromHex("-0x8000000000000000000000000000000000000000000000000000000000000000")
+		assert.Nil(t, err)
+		assert.Equal(t, MinI256, z)
+	})
+
+	t.Run("5. should return correct result", func(t *testing.T) {
+		z, err := FromHex("0x00007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+		assert.Nil(t, err)
+		assert.Equal(t, MaxI256, z)
+	})
+
+	t.Run("6. should return error overflow", func(t *testing.T) {
+		_, err := FromHex("0x8000000000000000000000000000000000000000000000000000000000000000")
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+
+	t.Run("7. should return error overflow", func(t *testing.T) {
+		_, err := FromHex("-0x10000000000000000000000000000000000000000000000000000000000000000")
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+
+	t.Run("8. should return error syntax", func(t *testing.T) {
+		_, err := FromHex("0x12g4")
+		assert.ErrorIs(t, err, ErrSyntax)
+	})
+
+	t.Run("9. should return error empty number", func(t *testing.T) {
+		_, err := FromHex("-0x")
+		assert.ErrorIs(t, err, ErrEmptyNumber)
+	})
+}
+
+func TestMustFromHex(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		z := MustFromHex("-0x1")
+		assert.Equal(t, "-1", z.Dec())
+	})
+
+	t.Run("2. should panic error", func(t *testing.T) {
+		assert.Panics(t, func() { MustFromHex("0xx1") })
+	})
+}
+
+func TestSetFromHex(t *testing.T) {
+	t.Run("1. should leave value unchanged on error", func(t *testing.T) {
+		z := NewInt(42)
+		err := z.SetFromHex("0xzz")
+		assert.Error(t, err)
+		assert.Equal(t, NewInt(42), z)
+	})
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		dec string
+		hex string
+	}{
+		{"0", "0x0"},
+		{"1", "0x1"},
+		{"-1", "-0x1"},
+		{"255", "0xff"},
+		{"-9223372036854775808", "-0x8000000000000000"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "-0x8000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		assert.Equal(t, test.hex, z.Hex(), "test %d", i)
+		assert.Equal(t, test.dec, MustFromHex(test.hex).Dec(), "test %d", i)
+	}
+}
+
+func TestAppendHex(t *testing.T) {
+	t.Run("1. should append to buffer", func(t *testing.T) {
+		buf := []byte("v=")
+		buf = MustFromDec("-4095").AppendHex(buf)
+		assert.Equal(t, "v=-0xfff", string(buf))
+	})
+
+	t.Run("2. should not allocate", func(t *testing.T) {
+		z := MustFromDec("-57896044618658097711785492504343953926634992332820282019728792003956564819968")
+		buf := make([]byte, 0, 128)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = z.AppendHex(buf[:0])
+		})
+		assert.Equal(t, float64(0), allocs)
+	})
+}
+
+func TestHexTwosComplement(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000000", new(Int).HexTwosComplement())
+	})
+
+	t.Run("2. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", NewInt(-1).HexTwosComplement())
+	})
+
+	t.Run("3. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "0x000000000000000000000000000000000000000000000000000000000000abcd", NewInt(0xabcd).HexTwosComplement())
+	})
+
+	t.Run("4. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "0x8000000000000000000000000000000000000000000000000000000000000000", MinI256.HexTwosComplement())
+	})
+}
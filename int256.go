@@ -261,6 +261,23 @@ func umulHop(z, x, y uint64) (hi, lo uint64) {
 	return hi, lo
 }
 
+// umulAdd sets z to x*m + a, treating x as unsigned, and returns the word carried out of the top limb.
+func (z *Int) umulAdd(x *Int, m, a uint64) uint64 {
+	var carry, c uint64
+	hi, lo := bits.Mul64(x[0], m)
+	z[0], c = bits.Add64(lo, a, 0)
+	carry = hi + c
+	hi, lo = bits.Mul64(x[1], m)
+	z[1], c = bits.Add64(lo, carry, 0)
+	carry = hi + c
+	hi, lo = bits.Mul64(x[2], m)
+	z[2], c = bits.Add64(lo, carry, 0)
+	carry = hi + c
+	hi, lo = bits.Mul64(x[3], m)
+	z[3], c = bits.Add64(lo, carry, 0)
+	return hi + c
+}
+
 func (z *Int) Clear() *Int {
 	z[0], z[1], z[2], z[3] = 0, 0, 0, 0
 	return z
@@ -585,3 +602,21 @@ func (z *Int) WriteToArray32(dest *[32]byte) {
 		dest[31-i] = byte(z[i/8] >> uint64(8*(i%8)))
 	}
 }
+
+// SetBytes32LE interprets in as the 32-byte little-endian two's complement representation of z
+func (z *Int) SetBytes32LE(in []byte) *Int {
+	_ = in[31] // bounds check hint to compiler; see golang.org/issue/14808
+	z[0] = binary.LittleEndian.Uint64(in[0:8])
+	z[1] = binary.LittleEndian.Uint64(in[8:16])
+	z[2] = binary.LittleEndian.Uint64(in[16:24])
+	z[3] = binary.LittleEndian.Uint64(in[24:32])
+	return z
+}
+
+// WriteToArray32LE writes all 32 bytes of z in little-endian order to the destination array, including zero-bytes
+func (z *Int) WriteToArray32LE(dest *[32]byte) {
+	binary.LittleEndian.PutUint64(dest[0:8], z[0])
+	binary.LittleEndian.PutUint64(dest[8:16], z[1])
+	binary.LittleEndian.PutUint64(dest[16:24], z[2])
+	binary.LittleEndian.PutUint64(dest[24:32], z[3])
+}
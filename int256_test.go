@@ -1157,3 +1157,25 @@ func TestSqrt(t *testing.T) {
 		assert.Panics(t, func() { new(Int).Sqrt(x) })
 	})
 }
+
+func TestBytes32LE(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		z := MustFromDec("-2")
+		var le, be [32]byte
+		z.WriteToArray32LE(&le)
+		z.WriteToArray32(&be)
+		assert.Equal(t, byte(0xfe), le[0])
+		assert.Equal(t, byte(0xff), le[31])
+		for i := range le {
+			assert.Equal(t, be[31-i], le[i])
+		}
+		assert.Equal(t, z, new(Int).SetBytes32LE(le[:]))
+	})
+
+	t.Run("2. should return correct result", func(t *testing.T) {
+		z := MustFromDec("12345678431937219573219471295439254379564372953245")
+		var le [32]byte
+		z.WriteToArray32LE(&le)
+		assert.Equal(t, z, new(Int).SetBytes32LE(le[:]))
+	})
+}
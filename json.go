@@ -0,0 +1,164 @@
+package int256
+
+import (
+	"bytes"
+	"strings"
+)
+
+// JSONNumber is an Int that marshals to a bare JSON number, e.g. -42.
+// It unmarshals from every form accepted by Int.UnmarshalJSON.
+type JSONNumber Int
+
+func (z JSONNumber) MarshalJSON() ([]byte, error) {
+	return (*Int)(&z).AppendDec(make([]byte, 0, len(maxAbsI256Dec)+1)), nil
+}
+
+func (z *JSONNumber) UnmarshalJSON(b []byte) error {
+	return (*Int)(z).UnmarshalJSON(b)
+}
+
+// JSONHex is an Int that marshals to a quoted minimal hex string, e.g. "-0x2a".
+// It unmarshals from every form accepted by Int.UnmarshalJSON.
+type JSONHex Int
+
+func (z JSONHex) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 69), '"')
+	b = (*Int)(&z).AppendHex(b)
+	return append(b, '"'), nil
+}
+
+func (z *JSONHex) UnmarshalJSON(b []byte) error {
+	return (*Int)(z).UnmarshalJSON(b)
+}
+
+// setFromJSONString sets z from the contents of a JSON string, which must be
+// either a decimal or a "0x" prefixed hex number with an optional minus sign.
+func (z *Int) setFromJSONString(b []byte) error {
+	digits := bytes.TrimPrefix(b, []byte{'-'})
+	if len(digits) >= 2 && digits[0] == '0' && (digits[1] == 'x' || digits[1] == 'X') {
+		return z.SetFromHex(string(b))
+	}
+	return z.SetFromDecBytes(b)
+}
+
+// setFromJSONNumber sets z from a JSON number literal, which must have an exact integer value.
+func (z *Int) setFromJSONNumber(b []byte) error {
+	// plain integers without leading zeros take the fast path
+	digits := bytes.TrimPrefix(b, []byte{'-'})
+	if len(digits) > 0 && (digits[0] != '0' || len(digits) == 1) && bytes.IndexAny(digits, ".eE") < 0 {
+		return z.SetFromDecBytes(b)
+	}
+	return z.setScientific(string(b))
+}
+
+// setScientific sets z from a number in JSON syntax, -?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?.
+// It fails with ErrNotInteger if the value has a fractional part.
+func (z *Int) setScientific(s string) error {
+	neg, s := splitSign(s)
+	intEnd := skipDigits(s, 0)
+	intPart := s[:intEnd]
+	if intEnd == 0 || (intEnd > 1 && s[0] == '0') {
+		return ErrSyntax
+	}
+	i := intEnd
+
+	var frac string
+	if i < len(s) && s[i] == '.' {
+		end := skipDigits(s, i+1)
+		if end == i+1 {
+			return ErrSyntax
+		}
+		frac = s[i+1 : end]
+		i = end
+	}
+
+	var exp string
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		exp, i = s[i+1:], len(s)
+		if exp == "" {
+			return ErrSyntax
+		}
+	}
+	if i != len(s) {
+		return ErrSyntax
+	}
+	return z.setScaled(neg, intPart, frac, exp, 0)
+}
+
+// setScaled sets z to the decimal intPart.frac, negated if neg is set, times
+// 10^(exp+shift), where exp is an exponent of the form [+-]?[0-9]+ or empty.
+// It fails with ErrNotInteger if the value has a fractional part and leaves z
+// unchanged on error.
+func (z *Int) setScaled(neg bool, intPart, frac, exp string, shift int) error {
+	if exp != "" {
+		// beyond this bound every non-zero value is out of range or fractional
+		// either way, given the small shifts used by callers
+		e, ok := parseExponent(exp, len(intPart)+len(frac)+len(maxAbsI256Dec))
+		if !ok {
+			return ErrSyntax
+		}
+		shift += e
+	}
+	shift -= len(frac)
+
+	digits := strings.TrimLeft(intPart+frac, "0")
+	if len(digits) == 0 {
+		z.Clear()
+		return nil
+	}
+	if shift < 0 {
+		if shift < -len(digits) || strings.Trim(digits[len(digits)+shift:], "0") != "" {
+			return ErrNotInteger
+		}
+		digits, shift = digits[:len(digits)+shift], 0
+	}
+	if len(digits) > len(maxAbsI256Dec) || shift > 76 {
+		return ErrOverflow
+	}
+	if neg {
+		digits = "-" + digits
+	}
+
+	var x Int
+	if err := x.SetFromDec(digits); err != nil {
+		return err
+	}
+	if shift > 0 {
+		// MinI256 is not a multiple of ten, so no product can be exactly MinI256
+		var r Int
+		if _, overflow := r.MulOverflow(&x, new(Int).pow10(shift)); overflow {
+			return ErrOverflow
+		}
+		x = r
+	}
+	z.Set(&x)
+	return nil
+}
+
+// parseExponent parses a decimal exponent with an optional sign, saturating its
+// magnitude at limit. It reports false if s is not of the form [+-]?[0-9]+.
+func parseExponent(s string, limit int) (int, bool) {
+	neg := len(s) > 0 && s[0] == '-'
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	if len(s) == 0 || skipDigits(s, 0) != len(s) {
+		return 0, false
+	}
+	v := 0
+	for i := 0; i < len(s) && v <= limit; i++ {
+		v = v*10 + int(s[i]-'0')
+	}
+	v = min(v, limit)
+	if neg {
+		return -v, true
+	}
+	return v, true
+}
+
+func skipDigits(s string, i int) int {
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	return i
+}
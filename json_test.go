@@ -0,0 +1,107 @@
+package int256
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONUnmarshalModes(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{`"123"`, "123"},
+		{`"-123"`, "-123"},
+		{`"0x7b"`, "123"},
+		{`"-0X7B"`, "-123"},
+		{`123`, "123"},
+		{`-0`, "0"},
+		{`1.0e3`, "1000"},
+		{`1.5e1`, "15"},
+		{`-2.50E+2`, "-250"},
+		{`12300e-2`, "123"},
+		{`0.0`, "0"},
+		{`0e99999999999999999999`, "0"},
+		{`0e9223372036854775807`, "0"},
+		{`0.0e-9223372036854775808`, "0"},
+		{`1` + strings.Repeat("0", 1500) + `e-1500`, "1"},
+		{`0.` + strings.Repeat("0", 1500) + `1e1501`, "1"},
+		{`5.7896044618658097711785492504343953926634992332820282019728792003956564819967e76`, "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+	}
+	for i, test := range tests {
+		var (
+			z   Int
+			num JSONNumber
+			hex JSONHex
+		)
+		assert.Nil(t, json.Unmarshal([]byte(test.in), &z), "test %d", i)
+		assert.Equal(t, test.out, z.Dec(), "test %d", i)
+		assert.Nil(t, json.Unmarshal([]byte(test.in), &num), "test %d", i)
+		assert.Equal(t, z, Int(num), "test %d", i)
+		assert.Nil(t, json.Unmarshal([]byte(test.in), &hex), "test %d", i)
+		assert.Equal(t, z, Int(hex), "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		for i, in := range []string{
+			`"1.5"`, `"1e3"`, `" 1"`, `"+1"`, `""`, `"-"`, `"0x"`, `"0xzz"`,
+			`1.5`, `1e-1`, `01`, `+1`, `.5`, `1.`, `1e`, `1e+`, `0x10`, `true`, `null`,
+			`1e99999999999999999999`, `1e-99999999999999999999`,
+			`1e9223372036854775807`, `1e-9223372036854775808`, `1.5e-9223372036854775808`,
+			`57896044618658097711785492504343953926634992332820282019728792003956564819968`,
+			`1e78`,
+		} {
+			var z Int
+			assert.Error(t, json.Unmarshal([]byte(in), &z), "test %d: %s", i, in)
+		}
+	})
+
+	t.Run("should return error syntax", func(t *testing.T) {
+		// json.Unmarshal rejects these before UnmarshalJSON sees them
+		for i, in := range []string{`1e`, `1e+`, `1e+-1`, `1.e1`, `01e1`, `1e1x`, `-`} {
+			var z Int
+			assert.ErrorIs(t, z.UnmarshalJSON([]byte(in)), ErrSyntax, "test %d: %s", i, in)
+		}
+	})
+
+	t.Run("should return error not integer", func(t *testing.T) {
+		var z Int
+		assert.ErrorIs(t, z.UnmarshalJSON([]byte(`1.25e1`)), ErrNotInteger)
+		assert.ErrorIs(t, z.UnmarshalJSON([]byte(`1e-9223372036854775808`)), ErrNotInteger)
+		assert.ErrorIs(t, z.UnmarshalJSON([]byte(`1.5e-9223372036854775808`)), ErrNotInteger)
+	})
+
+	t.Run("should return error overflow", func(t *testing.T) {
+		var z Int
+		assert.ErrorIs(t, z.UnmarshalJSON([]byte(`1e9223372036854775807`)), ErrOverflow)
+		assert.ErrorIs(t, z.UnmarshalJSON([]byte(`-1e9223372036854775807`)), ErrOverflow)
+	})
+}
+
+func TestJSONMarshalModes(t *testing.T) {
+	type quote struct {
+		Default *Int       `json:"default"`
+		Number  JSONNumber `json:"number"`
+		Hex     JSONHex    `json:"hex"`
+		HexPtr  *JSONHex   `json:"hexPtr"`
+	}
+	v := MustFromDec("-57896044618658097711785492504343953926634992332820282019728792003956564819968")
+	in := quote{
+		Default: v,
+		Number:  JSONNumber(*v),
+		Hex:     JSONHex(*NewInt(255)),
+		HexPtr:  (*JSONHex)(NewInt(-42)),
+	}
+	b, err := json.Marshal(in)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"default":"-57896044618658097711785492504343953926634992332820282019728792003956564819968",`+
+		`"number":-57896044618658097711785492504343953926634992332820282019728792003956564819968,`+
+		`"hex":"0xff","hexPtr":"-0x2a"}`, string(b))
+
+	var out quote
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,62 @@
+package int256
+
+// Ordered keys are fixed-width encodings whose lexicographic order matches
+// Cmp, for use as keys in sorted key-value stores. The binary key is the
+// 32-byte big-endian two's complement representation with the sign bit
+// flipped, so negative values sort before positive ones; the text key is the
+// binary key as 64 lower case hex digits.
+
+// OrderedKey returns the 32-byte order-preserving binary key of z.
+func (z *Int) OrderedKey() [32]byte {
+	var key [32]byte
+	z.WriteToArray32(&key)
+	key[0] ^= 0x80
+	return key
+}
+
+// AppendOrderedKey appends the 32-byte order-preserving binary key of z to dst.
+func (z *Int) AppendOrderedKey(dst []byte) []byte {
+	key := z.OrderedKey()
+	return append(dst, key[:]...)
+}
+
+// SetOrderedKey sets z from a binary key produced by OrderedKey.
+func (z *Int) SetOrderedKey(key []byte) error {
+	if len(key) != 32 {
+		return ErrInvalidEncoding
+	}
+	z.SetBytes32(key)
+	z[3] ^= 0x8000000000000000
+	return nil
+}
+
+// OrderedKeyText returns the 64 character order-preserving text key of z.
+func (z *Int) OrderedKeyText() string {
+	var out [64]byte
+	x := *z
+	x[3] ^= 0x8000000000000000
+	for i := 0; i < 64; i++ {
+		out[63-i] = hexDigits[(x[i/16]>>(4*uint(i%16)))&0xf]
+	}
+	return string(out[:])
+}
+
+// SetOrderedKeyText sets z from a text key produced by OrderedKeyText. Upper
+// case digits are rejected since they would not sort correctly.
+func (z *Int) SetOrderedKeyText(key string) error {
+	if len(key) != 64 {
+		return ErrInvalidEncoding
+	}
+	var x Int
+	for i := 0; i < 64; i++ {
+		c := key[63-i]
+		nib, ok := hexNibble(c)
+		if !ok || ('A' <= c && c <= 'F') {
+			return ErrInvalidEncoding
+		}
+		x[i/16] |= uint64(nib) << (4 * uint(i%16))
+	}
+	x[3] ^= 0x8000000000000000
+	z.Set(&x)
+	return nil
+}
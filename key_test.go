@@ -0,0 +1,77 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedKey(t *testing.T) {
+	tests := []struct {
+		dec string
+		key string
+	}{
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", strings.Repeat("00", 32)},
+		{"-1", "7f" + strings.Repeat("ff", 31)},
+		{"0", "80" + strings.Repeat("00", 31)},
+		{"1", "80" + strings.Repeat("00", 30) + "01"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", strings.Repeat("ff", 32)},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		key := z.OrderedKey()
+		assert.Equal(t, test.key, hex.EncodeToString(key[:]), "test %d", i)
+		assert.Equal(t, append([]byte{1}, key[:]...), z.AppendOrderedKey([]byte{1}), "test %d", i)
+		assert.Equal(t, test.key, z.OrderedKeyText(), "test %d", i)
+
+		var d Int
+		assert.Nil(t, d.SetOrderedKey(key[:]), "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+		assert.Nil(t, d.SetOrderedKeyText(test.key), "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		var z Int
+		assert.ErrorIs(t, z.SetOrderedKey(make([]byte, 31)), ErrInvalidEncoding)
+		assert.ErrorIs(t, z.SetOrderedKeyText(strings.Repeat("0", 63)), ErrInvalidEncoding)
+		assert.ErrorIs(t, z.SetOrderedKeyText("8"+strings.Repeat("0", 62)+"A"), ErrInvalidEncoding)
+		assert.ErrorIs(t, z.SetOrderedKeyText("8"+strings.Repeat("0", 62)+"g"), ErrInvalidEncoding)
+	})
+}
+
+func TestOrderedKeyOrder(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	values := []*Int{MinI256, MaxI256, NewInt(0), NewInt(1), NewInt(-1)}
+	for i := 0; i < 500; i++ {
+		// random magnitudes of random bit length, so that small values are common
+		b := new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), uint(rnd.Intn(255)+1)))
+		if rnd.Intn(2) == 0 {
+			b.Neg(b)
+		}
+		values = append(values, MustFromBig(b))
+	}
+
+	for i := 0; i < 5000; i++ {
+		x, y := values[rnd.Intn(len(values))], values[rnd.Intn(len(values))]
+		kx, ky := x.OrderedKey(), y.OrderedKey()
+		assert.Equal(t, x.Cmp(y), bytes.Compare(kx[:], ky[:]), "%s %s", x.Dec(), y.Dec())
+		assert.Equal(t, x.Cmp(y), strings.Compare(x.OrderedKeyText(), y.OrderedKeyText()), "%s %s", x.Dec(), y.Dec())
+	}
+
+	keys := make([]string, len(values))
+	for i, v := range values {
+		keys[i] = v.OrderedKeyText()
+	}
+	sort.Strings(keys)
+	sort.Slice(values, func(i, j int) bool { return values[i].Lt(values[j]) })
+	for i, key := range keys {
+		assert.Equal(t, values[i].OrderedKeyText(), key)
+	}
+}
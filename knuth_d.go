@@ -57,6 +57,15 @@ func udivrem(quot, u []uint64, d *Int) (rem Int) {
 	return rem
 }
 
+// udivremUint64 sets quot to x/d, treating x as unsigned, and returns the remainder.
+func udivremUint64(quot, x *Int, d uint64) (rem uint64) {
+	quot[3], rem = bits.Div64(0, x[3], d)
+	quot[2], rem = bits.Div64(rem, x[2], d)
+	quot[1], rem = bits.Div64(rem, x[1], d)
+	quot[0], rem = bits.Div64(rem, x[0], d)
+	return rem
+}
+
 func udivremBy1(quot, u []uint64, d uint64) (rem uint64) {
 	reciprocal := reciprocal2by1(d)
 	rem = u[len(u)-1]
@@ -0,0 +1,143 @@
+package int256
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// etherUnits maps the Solidity and web3 denomination names to their power of ten.
+var etherUnits = map[string]int{
+	"wei":        0,
+	"kwei":       3,
+	"babbage":    3,
+	"mwei":       6,
+	"lovelace":   6,
+	"gwei":       9,
+	"shannon":    9,
+	"szabo":      12,
+	"microether": 12,
+	"finney":     15,
+	"milliether": 15,
+	"ether":      18,
+}
+
+// ParseLiteral parses an integer literal as written in configuration files and
+// on the command line. It accepts
+//
+//   - decimal numbers with an optional minus sign and underscores between
+//     digits, e.g. "-1_000_000";
+//   - scientific notation, e.g. "1e18" or "1.5e6";
+//   - any of the above followed by whitespace and an ether denomination from
+//     wei to ether, e.g. "30 gwei" or "2.5 ether";
+//   - type(T).min and type(T).max for T one of int8 to int256 and uint8 to
+//     uint248, as well as int and uint.
+//
+// It fails with ErrNotInteger if the literal does not denote an integer and
+// with ErrOverflow if it is out of range.
+func ParseLiteral(s string) (*Int, error) {
+	z := new(Int)
+	if err := z.setLiteral(strings.TrimSpace(s)); err != nil {
+		return nil, fmt.Errorf("int256: cannot parse literal %q: %w", s, err)
+	}
+	return z, nil
+}
+
+func (z *Int) setLiteral(s string) error {
+	if strings.HasPrefix(s, "type(") {
+		return z.setTypeBound(s)
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		unit := strings.TrimLeft(s[i:], " \t")
+		e, ok := etherUnits[unit]
+		if !ok {
+			return fmt.Errorf("unknown unit %q: %w", unit, ErrSyntax)
+		}
+		s, exp = s[:i], e
+	}
+
+	digits, err := removeUnderscores(s)
+	if err != nil {
+		return err
+	}
+	neg, digits := splitSign(digits)
+
+	var e string
+	if i := strings.IndexAny(digits, "eE"); i >= 0 {
+		digits, e = digits[:i], digits[i+1:]
+		if len(e) == 0 {
+			return ErrSyntax
+		}
+	}
+	intPart, frac, _ := strings.Cut(digits, ".")
+	if len(intPart)+len(frac) == 0 {
+		return ErrEmptyNumber
+	}
+	if !isDecimalDigits(intPart) || !isDecimalDigits(frac) {
+		return ErrSyntax
+	}
+	return z.setScaled(neg, intPart, frac, e, exp)
+}
+
+// removeUnderscores strips the digit separators from s. An underscore must
+// stand between two digits.
+func removeUnderscores(s string) (string, error) {
+	if !strings.Contains(s, "_") {
+		return s, nil
+	}
+	isDigit := func(i int) bool { return i >= 0 && i < len(s) && s[i] >= '0' && s[i] <= '9' }
+	for i := 0; i < len(s); i++ {
+		if s[i] == '_' && (!isDigit(i-1) || !isDigit(i+1)) {
+			return "", ErrSyntax
+		}
+	}
+	return strings.ReplaceAll(s, "_", ""), nil
+}
+
+// setTypeBound sets z to the value of type(T).min or type(T).max.
+func (z *Int) setTypeBound(s string) error {
+	typ, member, ok := strings.Cut(strings.TrimPrefix(s, "type("), ").")
+	if !ok {
+		return ErrSyntax
+	}
+
+	signed := true
+	bitsStr := strings.TrimPrefix(typ, "int")
+	if strings.HasPrefix(typ, "uint") {
+		signed, bitsStr = false, strings.TrimPrefix(typ, "uint")
+	}
+	n := 256
+	if bitsStr != "" {
+		v, err := strconv.Atoi(bitsStr)
+		if err != nil || bitsStr[0] == '0' || v < 8 || v > 256 || v%8 != 0 {
+			return fmt.Errorf("invalid type %q: %w", typ, ErrSyntax)
+		}
+		n = v
+	} else if typ != "int" && typ != "uint" {
+		return fmt.Errorf("invalid type %q: %w", typ, ErrSyntax)
+	}
+
+	switch member {
+	case "min":
+		if !signed {
+			z.Clear()
+			return nil
+		}
+		// -(1 << (n-1)); for n == 256 this is MinI256 itself
+		z.Neg(new(Int).Lsh(new(Int).SetOne(), uint(n-1)))
+	case "max":
+		if !signed {
+			if n == 256 {
+				return ErrOverflow
+			}
+			z.Sub(new(Int).Lsh(new(Int).SetOne(), uint(n)), new(Int).SetOne())
+			return nil
+		}
+		z.Sub(new(Int).Lsh(new(Int).SetOne(), uint(n-1)), new(Int).SetOne())
+	default:
+		return fmt.Errorf("unknown member %q: %w", member, ErrSyntax)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package int256
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLiteral(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		tests := []struct {
+			in  string
+			out string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"007", "7"},
+			{"-1_000_000", "-1000000"},
+			{"1e18", "1000000000000000000"},
+			{"1E3", "1000"},
+			{"1.5e6", "1500000"},
+			{"-2.5e+1", "-25"},
+			{"15000e-3", "15"},
+			{"0e-99999999999999999999", "0"},
+			{"30 gwei", "30000000000"},
+			{"2.5 ether", "2500000000000000000"},
+			{"-0.5 finney", "-500000000000000"},
+			{"1 wei", "1"},
+			{"1e-9 ether", "1000000000"},
+			{"1_000\tszabo", "1000000000000000"},
+			{"  42  ", "42"},
+			{"0e9223372036854775807", "0"},
+			{"1" + strings.Repeat("0", 1500) + "e-1500", "1"},
+			{"0." + strings.Repeat("0", 1500) + "1e1501", "1"},
+			{"5e76", "50000000000000000000000000000000000000000000000000000000000000000000000000000"},
+			{"-5.7896044618658097711785492504343953926634992332820282019728792003956564819968e76", MinI256.Dec()},
+			{"-5789604461865809771178549250434395392663499233282028201972879200395656481996.8e1", MinI256.Dec()},
+			{"type(int256).min", MinI256.Dec()},
+			{"type(int256).max", MaxI256.Dec()},
+			{"type(int).max", MaxI256.Dec()},
+			{"type(int8).min", "-128"},
+			{"type(int8).max", "127"},
+			{"type(int64).min", "-9223372036854775808"},
+			{"type(uint8).max", "255"},
+			{"type(uint).min", "0"},
+			{"type(uint248).max", "452312848583266388373324160190187140051835877600158453279131187530910662655"},
+			{"57896044618658097711785492504343953926634992332820282019728792003956564819967", MaxI256.Dec()},
+		}
+		for i, test := range tests {
+			z, err := ParseLiteral(test.in)
+			assert.Nil(t, err, "test %d", i)
+			assert.Equal(t, test.out, z.Dec(), "test %d", i)
+		}
+	})
+
+	t.Run("2. should return error", func(t *testing.T) {
+		tests := []struct {
+			in  string
+			err error
+		}{
+			{"", ErrEmptyNumber},
+			{"1.5", ErrNotInteger},
+			{"1.5e0", ErrNotInteger},
+			{"1e-1", ErrNotInteger},
+			{"1 kwei_", ErrSyntax},
+			{"1.0000000001 gwei", ErrNotInteger},
+			{"1e99999999999999999999", ErrOverflow},
+			{"1e-99999999999999999999", ErrNotInteger},
+			{"1e9223372036854775807", ErrOverflow},
+			{"1e-9223372036854775808", ErrNotInteger},
+			{"1.5e-9223372036854775808", ErrNotInteger},
+			{"1e9223372036854775800 ether", ErrOverflow},
+			{"-1e9223372036854775800 ether", ErrOverflow},
+			{"1e-9223372036854775800 ether", ErrNotInteger},
+			{"1e+-5", ErrSyntax},
+			{"5.7896044618658097711785492504343953926634992332820282019728792003956564819968e76", ErrOverflow},
+			{"6e76", ErrOverflow},
+			{"1e77", ErrOverflow},
+			{"57896044618658097711785492504343953926634992332820282019728792003956564819968", ErrOverflow},
+			{"1e60 ether", ErrOverflow},
+			{"type(uint256).max", ErrOverflow},
+			{"1_", ErrSyntax},
+			{"_1", ErrSyntax},
+			{"1__0", ErrSyntax},
+			{"1_.5", ErrSyntax},
+			{"1e", ErrSyntax},
+			{"1e+", ErrSyntax},
+			{"+1", ErrSyntax},
+			{"0x10", ErrSyntax},
+			{"30 Gwei", ErrSyntax},
+			{"30 gwei ether", ErrSyntax},
+			{"type(int7).max", ErrSyntax},
+			{"type(int264).max", ErrSyntax},
+			{"type(int08).max", ErrSyntax},
+			{"type(bytes32).max", ErrSyntax},
+			{"type(int256).size", ErrSyntax},
+			{"type(int256)", ErrSyntax},
+		}
+		for i, test := range tests {
+			_, err := ParseLiteral(test.in)
+			assert.ErrorIs(t, err, test.err, "test %d: %s", i, test.in)
+		}
+	})
+}
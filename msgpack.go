@@ -0,0 +1,135 @@
+package int256
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MsgpackExtType is the MessagePack extension type used for values that do
+// not fit into an int64. It is part of the stable wire format and will not change.
+const MsgpackExtType int8 = 0x49 // 'I'
+
+// AppendMsgpack appends the MessagePack encoding of z to b. Values for which
+// IsInt64 holds use the smallest MessagePack integer format, all other values
+// use extension type MsgpackExtType carrying the shortest big-endian two's
+// complement representation of z.
+func (z *Int) AppendMsgpack(b []byte) []byte {
+	if z.IsInt64() {
+		return appendMsgpackInt64(b, z.Int64())
+	}
+
+	var buf [32]byte
+	z.WriteToArray32(&buf)
+	n := z.minimalLen()
+	switch n {
+	case 16:
+		b = append(b, 0xd8)
+	default:
+		b = append(b, 0xc7, byte(n))
+	}
+	b = append(b, byte(MsgpackExtType))
+	return append(b, buf[32-n:]...)
+}
+
+func appendMsgpackInt64(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= 0x7f:
+		return append(b, byte(v))
+	case v >= -32 && v < 0:
+		return append(b, byte(v))
+	case v >= 0 && v <= 0xff:
+		return append(b, 0xcc, byte(v))
+	case v >= 0 && v <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(v))
+	case v >= 0 && v <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(v))
+	case v >= 0:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), uint64(v))
+	case v >= -0x80:
+		return append(b, 0xd0, byte(v))
+	case v >= -0x8000:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(v))
+	case v >= -0x80000000:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(v))
+}
+
+// ReadMsgpack decodes a MessagePack integer or MsgpackExtType extension from
+// the start of b into z and returns the remaining bytes.
+func (z *Int) ReadMsgpack(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	lead := b[0]
+	switch {
+	case lead <= 0x7f:
+		z.SetUint64(uint64(lead))
+		return b[1:], nil
+	case lead >= 0xe0:
+		z.SetInt64(int64(int8(lead)))
+		return b[1:], nil
+	}
+
+	var size, extLen int
+	switch lead {
+	case 0xcc, 0xd0:
+		size = 1
+	case 0xcd, 0xd1:
+		size = 2
+	case 0xce, 0xd2:
+		size = 4
+	case 0xcf, 0xd3:
+		size = 8
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		extLen = 1 << (lead - 0xd4)
+	case 0xc7, 0xc8, 0xc9:
+		size = 1 << (lead - 0xc7)
+	default:
+		return nil, fmt.Errorf("int256: unexpected msgpack type byte 0x%02x", lead)
+	}
+	b = b[1:]
+	if len(b) < size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var v uint64
+	for _, c := range b[:size] {
+		v = v<<8 | uint64(c)
+	}
+	b = b[size:]
+
+	switch lead {
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		z.SetUint64(v)
+		return b, nil
+	case 0xd0:
+		z.SetInt64(int64(int8(v)))
+		return b, nil
+	case 0xd1:
+		z.SetInt64(int64(int16(v)))
+		return b, nil
+	case 0xd2:
+		z.SetInt64(int64(int32(v)))
+		return b, nil
+	case 0xd3:
+		z.SetInt64(int64(v))
+		return b, nil
+	case 0xc7, 0xc8, 0xc9:
+		if v > 32 {
+			return nil, ErrOverflow
+		}
+		extLen = int(v)
+	}
+
+	if len(b) < 1+extLen {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if typ := int8(b[0]); typ != MsgpackExtType {
+		return nil, fmt.Errorf("int256: unexpected msgpack ext type %d", typ)
+	}
+	if err := z.setMinimal(b[1 : 1+extLen]); err != nil {
+		return nil, err
+	}
+	return b[1+extLen:], nil
+}
@@ -0,0 +1,83 @@
+package int256
+
+import (
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgpack(t *testing.T) {
+	tests := []struct {
+		dec     string
+		msgpack string
+	}{
+		{"0", "00"},
+		{"127", "7f"},
+		{"128", "cc80"},
+		{"65535", "cdffff"},
+		{"65536", "ce00010000"},
+		{"4294967296", "cf0000000100000000"},
+		{"9223372036854775807", "cf7fffffffffffffff"},
+		{"-1", "ff"},
+		{"-32", "e0"},
+		{"-33", "d0df"},
+		{"-129", "d1ff7f"},
+		{"-32769", "d2ffff7fff"},
+		{"-2147483649", "d3ffffffff7fffffff"},
+		{"-9223372036854775808", "d38000000000000000"},
+		{"9223372036854775808", "c70949008000000000000000"},
+		{"-9223372036854775809", "c70949ff7fffffffffffffff"},
+		{"-170141183460469231731687303715884105728", "d84980000000000000000000000000000000"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "c720498000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		b := z.AppendMsgpack([]byte{0xc0})
+		assert.Equal(t, "c0"+test.msgpack, hex.EncodeToString(b), "test %d", i)
+
+		var d Int
+		rest, err := d.ReadMsgpack(append(b[1:], 0xc3))
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, []byte{0xc3}, rest, "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+	}
+}
+
+func TestReadMsgpack(t *testing.T) {
+	t.Run("1. should accept wider formats", func(t *testing.T) {
+		for i, in := range []string{"d3000000000000002a", "d20000002a", "c80001492a", "d4492a"} {
+			b, _ := hex.DecodeString(in)
+			var z Int
+			_, err := z.ReadMsgpack(b)
+			assert.Nil(t, err, "test %d", i)
+			assert.Equal(t, "42", z.Dec(), "test %d", i)
+		}
+	})
+
+	t.Run("2. should return error", func(t *testing.T) {
+		tests := []struct {
+			msgpack string
+			err     error
+		}{
+			{"", io.ErrUnexpectedEOF},
+			{"cd01", io.ErrUnexpectedEOF},
+			{"d749010203", io.ErrUnexpectedEOF},
+			{"c72149", ErrOverflow},
+		}
+		for i, test := range tests {
+			b, _ := hex.DecodeString(test.msgpack)
+			var z Int
+			_, err := z.ReadMsgpack(b)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+
+		for i, in := range []string{"c0", "a161", "d40101"} {
+			b, _ := hex.DecodeString(in)
+			var z Int
+			_, err := z.ReadMsgpack(b)
+			assert.Error(t, err, "test %d", i)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package int256
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullInt represents an Int that may be null. It implements sql.Scanner,
+// driver.Valuer, JSON and text marshaling in the same way as sql.NullInt64,
+// mapping SQL NULL, JSON null and empty text to Valid == false.
+type NullInt struct {
+	Int   Int
+	Valid bool // Valid is true if Int is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullInt) Scan(value any) error {
+	if value == nil {
+		n.Int, n.Valid = Int{}, false
+		return nil
+	}
+	err := n.Int.ScanSQL(value)
+	n.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer.
+func (n NullInt) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int.Dec(), nil
+}
+
+func (n NullInt) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Int.MarshalJSON()
+}
+
+func (n *NullInt) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Int, n.Valid = Int{}, false
+		return nil
+	}
+	err := n.Int.UnmarshalJSON(b)
+	n.Valid = err == nil
+	return err
+}
+
+func (n NullInt) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Int.MarshalText()
+}
+
+func (n *NullInt) UnmarshalText(input []byte) error {
+	if len(input) == 0 {
+		n.Int, n.Valid = Int{}, false
+		return nil
+	}
+	err := n.Int.UnmarshalText(input)
+	n.Valid = err == nil
+	return err
+}
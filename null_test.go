@@ -0,0 +1,114 @@
+package int256
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ sql.Scanner              = (*NullInt)(nil)
+	_ driver.Valuer            = NullInt{}
+	_ json.Marshaler           = NullInt{}
+	_ json.Unmarshaler         = (*NullInt)(nil)
+	_ encoding.TextMarshaler   = NullInt{}
+	_ encoding.TextUnmarshaler = (*NullInt)(nil)
+)
+
+func TestNullIntScan(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		var n NullInt
+		assert.Nil(t, n.Scan("-141243"))
+		assert.True(t, n.Valid)
+		assert.Equal(t, "-141243", n.Int.Dec())
+	})
+
+	t.Run("2. should scan NULL", func(t *testing.T) {
+		n := NullInt{Int: *NewInt(5), Valid: true}
+		assert.Nil(t, n.Scan(nil))
+		assert.False(t, n.Valid)
+		assert.True(t, n.Int.IsZero())
+	})
+
+	t.Run("3. should return error", func(t *testing.T) {
+		var n NullInt
+		assert.Error(t, n.Scan("1.5"))
+		assert.False(t, n.Valid)
+	})
+}
+
+func TestNullIntValue(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		v, err := NullInt{Int: *NewInt(-7), Valid: true}.Value()
+		assert.Nil(t, err)
+		assert.Equal(t, "-7", v)
+	})
+
+	t.Run("2. should return nil", func(t *testing.T) {
+		v, err := NullInt{Int: *NewInt(-7)}.Value()
+		assert.Nil(t, err)
+		assert.Nil(t, v)
+	})
+}
+
+func TestNullIntJSON(t *testing.T) {
+	type order struct {
+		Amount NullInt  `json:"amount"`
+		Limit  NullInt  `json:"limit"`
+		Fee    *NullInt `json:"fee"`
+	}
+
+	t.Run("1. should round trip", func(t *testing.T) {
+		in := order{
+			Amount: NullInt{Int: *MustFromDec("-57896044618658097711785492504343953926634992332820282019728792003956564819968"), Valid: true},
+			Fee:    &NullInt{Int: *NewInt(3), Valid: true},
+		}
+		b, err := json.Marshal(in)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"amount":"-57896044618658097711785492504343953926634992332820282019728792003956564819968","limit":null,"fee":"3"}`, string(b))
+
+		var out order
+		assert.Nil(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("2. should decode null", func(t *testing.T) {
+		out := order{Amount: NullInt{Int: *NewInt(1), Valid: true}}
+		assert.Nil(t, json.Unmarshal([]byte(`{"amount":null,"limit":12}`), &out))
+		assert.False(t, out.Amount.Valid)
+		assert.True(t, out.Limit.Valid)
+		assert.Equal(t, "12", out.Limit.Int.Dec())
+	})
+
+	t.Run("3. should return error", func(t *testing.T) {
+		var out order
+		assert.Error(t, json.Unmarshal([]byte(`{"amount":"abc"}`), &out))
+	})
+}
+
+func TestNullIntText(t *testing.T) {
+	t.Run("1. should round trip", func(t *testing.T) {
+		b, err := NullInt{Int: *NewInt(-99), Valid: true}.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, "-99", string(b))
+
+		var n NullInt
+		assert.Nil(t, n.UnmarshalText(b))
+		assert.True(t, n.Valid)
+		assert.Equal(t, "-99", n.Int.Dec())
+	})
+
+	t.Run("2. should handle empty text", func(t *testing.T) {
+		b, err := NullInt{}.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, "", string(b))
+
+		n := NullInt{Valid: true}
+		assert.Nil(t, n.UnmarshalText(b))
+		assert.False(t, n.Valid)
+	})
+}
@@ -0,0 +1,94 @@
+package int256
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgNumeric(t *testing.T) {
+	tests := []struct {
+		dec     string
+		numeric string
+	}{
+		{"0", "0000" + "0000" + "0000" + "0000"},
+		{"1", "0001" + "0000" + "0000" + "0000" + "0001"},
+		{"-1", "0001" + "0000" + "4000" + "0000" + "0001"},
+		{"10000", "0001" + "0001" + "0000" + "0000" + "0001"},
+		{"12345678", "0002" + "0001" + "0000" + "0000" + "04d2" + "162e"},
+		{"-100000000000000000000", "0001" + "0005" + "4000" + "0000" + "0001"},
+		{"1000000000000000000000001", "0007" + "0006" + "0000" + "0000" + "0001" + "0000" + "0000" + "0000" + "0000" + "0000" + "0001"},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		b := z.EncodePgNumeric([]byte{0xaa})
+		assert.Equal(t, "aa"+test.numeric, hex.EncodeToString(b), "test %d", i)
+
+		var d Int
+		assert.Nil(t, d.DecodePgNumeric(b[1:]), "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+	}
+
+	t.Run("should round trip range boundaries", func(t *testing.T) {
+		for i, z := range []*Int{MinI256, MaxI256} {
+			var d Int
+			assert.Nil(t, d.DecodePgNumeric(z.EncodePgNumeric(nil)), "test %d", i)
+			assert.Equal(t, z, &d, "test %d", i)
+		}
+	})
+}
+
+func TestDecodePgNumeric(t *testing.T) {
+	t.Run("1. should accept zero fraction digits and display scale", func(t *testing.T) {
+		// 42.0000 with dscale 4
+		b, _ := hex.DecodeString("0002" + "0000" + "0000" + "0004" + "002a" + "0000")
+		var z Int
+		assert.Nil(t, z.DecodePgNumeric(b))
+		assert.Equal(t, "42", z.Dec())
+	})
+
+	t.Run("2. should return error not integer", func(t *testing.T) {
+		for i, in := range []string{
+			"0002" + "0000" + "0000" + "0001" + "002a" + "1388", // 42.5
+			"0001" + "ffff" + "0000" + "0004" + "0001",          // 0.0001
+			"0000" + "0000" + "c000" + "0000",                   // NaN
+			"0000" + "0000" + "d000" + "0000",                   // Infinity
+		} {
+			b, _ := hex.DecodeString(in)
+			var z Int
+			assert.ErrorIs(t, z.DecodePgNumeric(b), ErrNotInteger, "test %d", i)
+		}
+	})
+
+	t.Run("3. should return error overflow", func(t *testing.T) {
+		for i, in := range []string{
+			"0001" + "0014" + "0000" + "0000" + "0001", // 10^80
+			"0001" + "7fff" + "0000" + "0000" + "0001",
+		} {
+			b, _ := hex.DecodeString(in)
+			var z Int
+			assert.ErrorIs(t, z.DecodePgNumeric(b), ErrOverflow, "test %d", i)
+		}
+
+		// 2^255 is only in range when negative
+		b := MinI256.EncodePgNumeric(nil)
+		b[4], b[5] = 0, 0
+		var z Int
+		assert.ErrorIs(t, z.DecodePgNumeric(b), ErrOverflow)
+	})
+
+	t.Run("4. should return error invalid encoding", func(t *testing.T) {
+		for i, in := range []string{
+			"",
+			"0001" + "0000" + "0000" + "0000",
+			"0001" + "0000" + "0000" + "0000" + "2710",
+			"0000" + "0000" + "1234" + "0000",
+			"ffff" + "0000" + "0000" + "0000",
+		} {
+			b, _ := hex.DecodeString(in)
+			var z Int
+			assert.ErrorIs(t, z.DecodePgNumeric(b), ErrInvalidEncoding, "test %d", i)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package int256
+
+import (
+	"errors"
+	"io"
+)
+
+// RLP has no notion of signed integers, so an Int is encoded as the unsigned
+// 256-bit integer with the same bit pattern, i.e. the canonical minimal
+// big-endian byte string of its two's complement representation. Zero is the
+// empty string 0x80, small non-negative values are a single byte and negative
+// values always take 32 bytes. This matches go-ethereum's encoding of uint256
+// values cast from int256.
+
+var (
+	ErrRLPCanonInt       = errors.New("int256: rlp: non-canonical integer (leading zero bytes)")
+	ErrRLPCanonSize      = errors.New("int256: rlp: non-canonical size information")
+	ErrRLPExpectedString = errors.New("int256: rlp: expected string, got list")
+)
+
+// AppendRLP appends the RLP encoding of z to buf and returns the extended buffer.
+func (z *Int) AppendRLP(buf []byte) []byte {
+	n := (z.BitLen() + 7) / 8
+	switch {
+	case n == 0:
+		return append(buf, 0x80)
+	case n == 1 && z[0] < 0x80:
+		return append(buf, byte(z[0]))
+	}
+	var b [32]byte
+	z.WriteToArray32(&b)
+	buf = append(buf, 0x80+byte(n))
+	return append(buf, b[32-n:]...)
+}
+
+// EncodeRLP writes the RLP encoding of z to w. It implements go-ethereum's rlp.Encoder.
+func (z *Int) EncodeRLP(w io.Writer) error {
+	var buf [33]byte
+	_, err := w.Write(z.AppendRLP(buf[:0]))
+	return err
+}
+
+// ParseRLP decodes the RLP string at the start of b and returns the value and
+// the remaining bytes. Non-canonical encodings, including leading zero bytes,
+// are rejected.
+func ParseRLP(b []byte) (*Int, []byte, error) {
+	if len(b) == 0 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	n, inline, err := rlpStringHeader(b[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if inline {
+		return new(Int).SetUint64(uint64(b[0])), b[1:], nil
+	}
+	if len(b) < 1+n {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	var z Int
+	if err := z.setRLPPayload(b[1 : 1+n]); err != nil {
+		return nil, nil, err
+	}
+	return &z, b[1+n:], nil
+}
+
+// DecodeRLP reads exactly one RLP string from r and stores its value in z.
+// go-ethereum's rlp.Decoder takes an *rlp.Stream, which cannot be named
+// without importing go-ethereum; wrap this method to adapt it.
+func (z *Int) DecodeRLP(r io.Reader) error {
+	var buf [33]byte
+	if _, err := io.ReadFull(r, buf[:1]); err != nil {
+		return err
+	}
+	n, inline, err := rlpStringHeader(buf[0])
+	if err != nil {
+		return err
+	}
+	if inline {
+		z.SetUint64(uint64(buf[0]))
+		return nil
+	}
+	if _, err := io.ReadFull(r, buf[1:1+n]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return z.setRLPPayload(buf[1 : 1+n])
+}
+
+// rlpStringHeader returns the payload size announced by the RLP prefix byte,
+// or inline == true when the prefix byte is itself the value.
+func rlpStringHeader(prefix byte) (size int, inline bool, err error) {
+	switch {
+	case prefix == 0:
+		return 0, false, ErrRLPCanonInt
+	case prefix < 0x80:
+		return 0, true, nil
+	case prefix <= 0x80+32:
+		return int(prefix - 0x80), false, nil
+	case prefix < 0xc0:
+		return 0, false, ErrOverflow
+	}
+	return 0, false, ErrRLPExpectedString
+}
+
+func (z *Int) setRLPPayload(p []byte) error {
+	switch {
+	case len(p) == 1 && p[0] < 0x80:
+		return ErrRLPCanonSize
+	case len(p) > 0 && p[0] == 0:
+		return ErrRLPCanonInt
+	}
+	var buf [32]byte
+	copy(buf[32-len(p):], p)
+	z.SetBytes32(buf[:])
+	return nil
+}
@@ -0,0 +1,77 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRLP(t *testing.T) {
+	tests := []struct {
+		dec string
+		rlp string
+	}{
+		{"0", "80"},
+		{"1", "01"},
+		{"127", "7f"},
+		{"128", "8180"},
+		{"256", "820100"},
+		{"1024", "820400"},
+		{"-1", "a0ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "a08000000000000000000000000000000000000000000000000000000000000000"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", "a07fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		enc := z.AppendRLP(nil)
+		assert.Equal(t, test.rlp, hex.EncodeToString(enc), "test %d", i)
+
+		var buf bytes.Buffer
+		assert.Nil(t, z.EncodeRLP(&buf), "test %d", i)
+		assert.Equal(t, enc, buf.Bytes(), "test %d", i)
+
+		v, rest, err := ParseRLP(append(enc, 0xc0))
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, []byte{0xc0}, rest, "test %d", i)
+		assert.Equal(t, z, v, "test %d", i)
+
+		r := bytes.NewReader(append(enc, 0xc0))
+		var d Int
+		assert.Nil(t, d.DecodeRLP(r), "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+		assert.Equal(t, 1, r.Len(), "test %d", i)
+	}
+}
+
+func TestParseRLPError(t *testing.T) {
+	tests := []struct {
+		rlp string
+		err error
+	}{
+		{"", io.ErrUnexpectedEOF},
+		{"00", ErrRLPCanonInt},
+		{"8100", ErrRLPCanonSize},
+		{"817f", ErrRLPCanonSize},
+		{"820001", ErrRLPCanonInt},
+		{"8201", io.ErrUnexpectedEOF},
+		{"a1010000000000000000000000000000000000000000000000000000000000000000", ErrOverflow},
+		{"b840", ErrOverflow},
+		{"c0", ErrRLPExpectedString},
+	}
+	for i, test := range tests {
+		b, _ := hex.DecodeString(test.rlp)
+		_, _, err := ParseRLP(b)
+		assert.ErrorIs(t, err, test.err, "test %d", i)
+
+		var z Int
+		err = z.DecodeRLP(bytes.NewReader(b))
+		if test.rlp == "" {
+			assert.ErrorIs(t, err, io.EOF, "test %d", i)
+		} else {
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package int256
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ScanSQL reads z from a NUMERIC(78,0) or integer column value. It accepts
+// string, []byte, int64 and integral float64 values; a fractional part is only
+// accepted when it is all zeros.
+//
+// Int cannot implement sql.Scanner directly because its Scan method implements
+// fmt.Scanner; pass z.SQLScanner() to Rows.Scan instead, or use NullInt.
+func (z *Int) ScanSQL(src any) error {
+	switch v := src.(type) {
+	case string:
+		return z.scanDec(v)
+	case []byte:
+		return z.scanDec(string(v))
+	case int64:
+		z.SetInt64(v)
+		return nil
+	case float64:
+		return z.scanFloat(v)
+	case nil:
+		return fmt.Errorf("int256: cannot scan NULL into Int")
+	}
+	return fmt.Errorf("int256: cannot scan %T into Int", src)
+}
+
+// SQLScanner returns an sql.Scanner that stores scanned values into z using ScanSQL.
+func (z *Int) SQLScanner() sql.Scanner {
+	return (*sqlScanner)(z)
+}
+
+type sqlScanner Int
+
+func (s *sqlScanner) Scan(src any) error {
+	return (*Int)(s).ScanSQL(src)
+}
+
+func (z *Int) scanDec(s string) error {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		if strings.Trim(s[i+1:], "0") != "" {
+			return fmt.Errorf("int256: cannot scan fractional value %q into Int", s)
+		}
+		s = s[:i]
+	}
+	if err := z.SetFromDec(s); err != nil {
+		return fmt.Errorf("int256: cannot scan %q into Int: %w", s, err)
+	}
+	return nil
+}
+
+func (z *Int) scanFloat(f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return fmt.Errorf("int256: cannot scan non-integral value %v into Int", f)
+	}
+	b, _ := big.NewFloat(f).Int(nil)
+	var x Int
+	if overflow := x.SetFromBig(b); overflow {
+		return fmt.Errorf("int256: cannot scan %v into Int: %w", f, ErrOverflow)
+	}
+	z.Set(&x)
+	return nil
+}
+
+// Value implements driver.Valuer, encoding z as its decimal string. A nil z is stored as NULL.
+func (z *Int) Value() (driver.Value, error) {
+	if z == nil {
+		return nil, nil
+	}
+	return z.Dec(), nil
+}
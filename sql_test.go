@@ -0,0 +1,81 @@
+package int256
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ driver.Valuer = (*Int)(nil)
+
+func TestScanSQL(t *testing.T) {
+	tests := []struct {
+		src any
+		dec string
+	}{
+		{"12345678431937219573219471295439254379564372953245", "12345678431937219573219471295439254379564372953245"},
+		{[]byte("-57896044618658097711785492504343953926634992332820282019728792003956564819968"), "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"42.000", "42"},
+		{int64(math.MinInt64), "-9223372036854775808"},
+		{float64(-1e20), "-100000000000000000000"},
+		{float64(0), "0"},
+	}
+	for i, test := range tests {
+		var z Int
+		err := z.ScanSQL(test.src)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.dec, z.Dec(), "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		for i, src := range []any{
+			nil,
+			"1.5",
+			"abc",
+			"57896044618658097711785492504343953926634992332820282019728792003956564819968",
+			float64(0.5),
+			math.NaN(),
+			math.Inf(1),
+			float64(1e80),
+			true,
+		} {
+			z := NewInt(7)
+			assert.Error(t, z.ScanSQL(src), "test %d", i)
+			assert.Equal(t, NewInt(7), z, "test %d: should leave z unchanged", i)
+		}
+	})
+
+	t.Run("should return error overflow", func(t *testing.T) {
+		z := NewInt(7)
+		assert.ErrorIs(t, z.ScanSQL("-57896044618658097711785492504343953926634992332820282019728792003956564819969"), ErrOverflow)
+		assert.ErrorIs(t, z.ScanSQL(float64(1e77)), ErrOverflow)
+		assert.Equal(t, NewInt(7), z)
+	})
+}
+
+func TestValue(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		v, err := MustFromDec("-141243").Value()
+		assert.Nil(t, err)
+		assert.Equal(t, "-141243", v)
+	})
+
+	t.Run("2. should return nil for nil value", func(t *testing.T) {
+		var z *Int
+		v, err := z.Value()
+		assert.Nil(t, err)
+		assert.Nil(t, v)
+	})
+}
+
+func TestSQLScanner(t *testing.T) {
+	t.Run("1. should scan into the underlying value", func(t *testing.T) {
+		var z Int
+		var s sql.Scanner = z.SQLScanner()
+		assert.Nil(t, s.Scan("-42"))
+		assert.Equal(t, "-42", z.Dec())
+	})
+}
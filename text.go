@@ -0,0 +1,195 @@
+package int256
+
+import "math/bits"
+
+const (
+	MaxBase = 10 + ('z' - 'a' + 1) + ('Z' - 'A' + 1)
+
+	digits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// SetString sets z to the value of s, interpreted in the given base, and
+// returns z and a boolean indicating success. It follows the semantics of
+// big.Int.SetString: the value may be preceded by "+" or "-", and for base 0
+// the prefixes "0b", "0o", "0x" (and a bare leading "0" for octal) select the
+// base while underscores may separate digits. Values outside the signed
+// 256-bit range are rejected. On failure z is left unchanged.
+func (z *Int) SetString(s string, base int) (*Int, bool) {
+	if err := z.setString(s, base); err != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+func (z *Int) setString(s string, base int) error {
+	var isNeg bool
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		isNeg = s[0] == '-'
+		s = s[1:]
+	}
+
+	var (
+		underscoreOk bool
+		prevDigit    bool // the previous character was a digit or a base prefix
+	)
+	switch {
+	case base == 0:
+		base, underscoreOk = 10, true
+		if len(s) > 0 && s[0] == '0' {
+			base = 8
+			if len(s) > 1 {
+				switch s[1] {
+				case 'x', 'X':
+					base, s, prevDigit = 16, s[2:], true
+				case 'o', 'O':
+					base, s, prevDigit = 8, s[2:], true
+				case 'b', 'B':
+					base, s, prevDigit = 2, s[2:], true
+				}
+			}
+		}
+	case base < 2 || base > MaxBase:
+		return ErrSyntax
+	}
+
+	var (
+		x        Int
+		overflow bool
+		word     uint64
+		mult     uint64 = 1
+		ndigits  int
+		maxMult  = ^uint64(0) / uint64(base)
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' {
+			if !underscoreOk || !prevDigit {
+				return ErrSyntax
+			}
+			prevDigit = false
+			continue
+		}
+		d, ok := digitValue(c, base)
+		if !ok {
+			return ErrSyntax
+		}
+		prevDigit = true
+		ndigits++
+		word = word*uint64(base) + d
+		mult *= uint64(base)
+		if mult > maxMult {
+			overflow = overflow || x.umulAdd(&x, mult, word) != 0
+			word, mult = 0, 1
+		}
+	}
+	if ndigits == 0 {
+		return ErrEmptyNumber
+	}
+	if !prevDigit {
+		return ErrSyntax
+	}
+	if mult > 1 {
+		overflow = overflow || x.umulAdd(&x, mult, word) != 0
+	}
+	if overflow || (x.IsNegative() && !(isNeg && x.IsMinI256())) {
+		return ErrOverflow
+	}
+
+	z.Set(&x)
+	if isNeg {
+		z.Neg(z)
+	}
+	return nil
+}
+
+func digitValue(c byte, base int) (uint64, bool) {
+	var d uint64
+	switch {
+	case '0' <= c && c <= '9':
+		d = uint64(c - '0')
+	case 'a' <= c && c <= 'z':
+		d = uint64(c-'a') + 10
+	case 'A' <= c && c <= 'Z':
+		if base <= 36 {
+			d = uint64(c-'A') + 10
+		} else {
+			d = uint64(c-'A') + 36
+		}
+	default:
+		return 0, false
+	}
+	return d, d < uint64(base)
+}
+
+// Text returns the string representation of z in the given base, which must
+// be between 2 and 62. Digits above 9 use lower case letters first and then,
+// for bases above 36, upper case letters.
+func (z *Int) Text(base int) string {
+	if z == nil {
+		return "<nil>"
+	}
+	return string(z.Append(make([]byte, 0, 258), base))
+}
+
+// Append appends the string representation of z in the given base, as
+// generated by z.Text, to buf and returns the extended buffer.
+func (z *Int) Append(buf []byte, base int) []byte {
+	if z == nil {
+		return append(buf, "<nil>"...)
+	}
+	x := *z
+	if x.IsNegative() {
+		buf = append(buf, '-')
+		x.Neg(&x)
+	}
+	return x.appendUnsigned(buf, base)
+}
+
+// appendUnsigned appends the digits of z, treated as unsigned, in the given base.
+func (z *Int) appendUnsigned(buf []byte, base int) []byte {
+	if base < 2 || base > MaxBase {
+		panic("int256: invalid base")
+	}
+	if base == 10 {
+		return z.appendDecUnsigned(buf)
+	}
+	if z.IsZero() {
+		return append(buf, '0')
+	}
+
+	var (
+		out [256]byte
+		pos = len(out)
+		x   = *z
+	)
+	if base&(base-1) == 0 {
+		shift := uint(bits.TrailingZeros(uint(base)))
+		mask := uint64(base - 1)
+		for off := uint(0); off < uint(x.BitLen()); off += shift {
+			w, b := off/64, off%64
+			d := x[w] >> b
+			if b+shift > 64 && w < 3 {
+				d |= x[w+1] << (64 - b)
+			}
+			pos--
+			out[pos] = digits[d&mask]
+		}
+		return append(buf, out[pos:]...)
+	}
+
+	// divide by the largest power of base that fits into a word and emit its digits in bulk
+	bb, n := uint64(base), 1
+	for bb <= ^uint64(0)/uint64(base) {
+		bb *= uint64(base)
+		n++
+	}
+	for !x.IsZero() {
+		r := udivremUint64(&x, &x, bb)
+		for i := 0; i < n && (r != 0 || !x.IsZero()); i++ {
+			pos--
+			out[pos] = digits[r%uint64(base)]
+			r /= uint64(base)
+		}
+	}
+	return append(buf, out[pos:]...)
+}
@@ -0,0 +1,96 @@
+package int256
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetString(t *testing.T) {
+	tests := []struct {
+		in   string
+		base int
+		out  string
+		ok   bool
+	}{
+		{"0", 0, "0", true},
+		{"-0", 10, "0", true},
+		{"+42", 10, "42", true},
+		{"0x_dead_BEEF", 0, "3735928559", true},
+		{"-0X1f", 0, "-31", true},
+		{"0o777", 0, "511", true},
+		{"0777", 0, "511", true},
+		{"0b1010_1010", 0, "170", true},
+		{"1_000_000", 0, "1000000", true},
+		{"zz", 36, "1295", true},
+		{"ZZ", 36, "1295", true},
+		{"ZZ", 62, "3843", true},
+		{"-8000000000000000000000000000000000000000000000000000000000000000", 16, "-57896044618658097711785492504343953926634992332820282019728792003956564819968", true},
+		{"7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16, "57896044618658097711785492504343953926634992332820282019728792003956564819967", true},
+		{"8000000000000000000000000000000000000000000000000000000000000000", 16, "", false},
+		{"-10000000000000000000000000000000000000000000000000000000000000000", 16, "", false},
+		{"1_000", 10, "", false},
+		{"_1000", 0, "", false},
+		{"1000_", 0, "", false},
+		{"1__000", 0, "", false},
+		{"0x", 0, "", false},
+		{"", 10, "", false},
+		{"-", 10, "", false},
+		{"12", 2, "", false},
+		{"0x10", 10, "", false},
+		{"10", 1, "", false},
+		{"10", 63, "", false},
+	}
+	for i, test := range tests {
+		z := new(Int)
+		res, ok := z.SetString(test.in, test.base)
+		assert.Equal(t, test.ok, ok, "test %d: %q", i, test.in)
+		if test.ok {
+			assert.Equal(t, test.out, res.Dec(), "test %d: %q", i, test.in)
+		} else {
+			assert.Nil(t, res, "test %d: %q", i, test.in)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Run("1. should return correct result", func(t *testing.T) {
+		assert.Equal(t, "-ff", NewInt(-255).Text(16))
+		assert.Equal(t, "0", new(Int).Text(2))
+		assert.Equal(t, "Z", NewInt(61).Text(62))
+		assert.Equal(t, "-1"+strings.Repeat("0", 85), MinI256.Text(8))
+	})
+
+	t.Run("2. should match big.Int for random values", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		lim := new(big.Int).Lsh(big.NewInt(1), 255)
+		for i := 0; i < 1000; i++ {
+			b := new(big.Int).Rand(rnd, lim)
+			if i%2 == 1 {
+				b.Neg(b)
+			}
+			z := MustFromBig(b)
+			base := 2 + i%61
+			s := z.Text(base)
+			assert.Equal(t, b.Text(base), s)
+			r, ok := new(Int).SetString(s, base)
+			assert.True(t, ok)
+			assert.Equal(t, z, r)
+		}
+	})
+
+	t.Run("3. should panic on invalid base", func(t *testing.T) {
+		assert.Panics(t, func() { NewInt(1).Text(63) })
+	})
+}
+
+func TestAppend(t *testing.T) {
+	t.Run("1. should append to buffer", func(t *testing.T) {
+		buf := []byte("x=")
+		buf = NewInt(-5).Append(buf, 2)
+		assert.Equal(t, "x=-101", string(buf))
+	})
+}
@@ -0,0 +1,221 @@
+package int256
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RoundingMode selects how a value is rounded when digits are dropped.
+// The zero value is RoundHalfEven.
+type RoundingMode byte
+
+const (
+	RoundHalfEven RoundingMode = iota // to nearest, ties to the even neighbour
+	RoundHalfUp                       // to nearest, ties away from zero
+	RoundHalfDown                     // to nearest, ties toward zero
+	RoundDown                         // toward zero
+	RoundUp                           // away from zero
+	RoundFloor                        // toward negative infinity
+	RoundCeiling                      // toward positive infinity
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundDown:
+		return "Down"
+	case RoundUp:
+		return "Up"
+	case RoundFloor:
+		return "Floor"
+	case RoundCeiling:
+		return "Ceiling"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", byte(m))
+}
+
+func (m RoundingMode) valid() bool {
+	return m <= RoundCeiling
+}
+
+// MaxDecimals is the largest number of decimals accepted by FormatUnits and
+// Formatter, matching the uint8 decimals of ERC-20 tokens.
+const MaxDecimals = 255
+
+var ErrExcessPrecision = errors.New("int256: more decimal places than allowed")
+
+// FormatUnits returns z divided by 10^decimals as an exact decimal string
+// without trailing fractional zeros, e.g. FormatUnits(1500000000000000000, 18)
+// is "1.5" and FormatUnits(-5, 1) is "-0.5". It panics if decimals is not
+// between 0 and MaxDecimals.
+func FormatUnits(z *Int, decimals int) string {
+	if decimals < 0 || decimals > MaxDecimals {
+		panic("int256: decimals out of range")
+	}
+	neg, digits := splitSign(z.Dec())
+	intPart, frac := splitDecimals(digits, decimals)
+	frac = strings.TrimRight(frac, "0")
+
+	var sb strings.Builder
+	sb.Grow(len(intPart) + len(frac) + 2)
+	if neg {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(intPart)
+	if len(frac) > 0 {
+		sb.WriteByte('.')
+		sb.WriteString(frac)
+	}
+	return sb.String()
+}
+
+// ParseUnits parses a decimal string such as "1.5" or "-0.25" and returns its
+// value multiplied by 10^decimals, e.g. ParseUnits("1.5", 18) is
+// 1500000000000000000. It fails with ErrExcessPrecision if s has more than
+// decimals non-zero fractional digits.
+func ParseUnits(s string, decimals int) (*Int, error) {
+	return parseUnits(s, decimals, 0, true)
+}
+
+// ParseUnitsRound is like ParseUnits but rounds excess fractional digits with
+// the given mode. It fails for modes other than the defined constants.
+func ParseUnitsRound(s string, decimals int, mode RoundingMode) (*Int, error) {
+	if !mode.valid() {
+		return nil, fmt.Errorf("int256: invalid rounding mode %v", mode)
+	}
+	return parseUnits(s, decimals, mode, false)
+}
+
+func parseUnits(s string, decimals int, mode RoundingMode, strict bool) (*Int, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("int256: negative decimals %d", decimals)
+	}
+	neg, unsigned := splitSign(s)
+	intPart, frac, _ := strings.Cut(unsigned, ".")
+	if len(intPart)+len(frac) == 0 {
+		return nil, fmt.Errorf("int256: cannot parse %q: %w", s, ErrEmptyNumber)
+	}
+	if !isDecimalDigits(intPart) || !isDecimalDigits(frac) {
+		return nil, fmt.Errorf("int256: cannot parse %q: %w", s, ErrSyntax)
+	}
+
+	var away bool
+	if len(frac) > decimals {
+		var dropped string
+		frac, dropped = frac[:decimals], frac[decimals:]
+		if strings.Trim(dropped, "0") != "" {
+			if strict {
+				return nil, fmt.Errorf("int256: cannot parse %q with %d decimals: %w", s, decimals, ErrExcessPrecision)
+			}
+			away = roundsAway(mode, neg, lastDigit(intPart+frac), compareHalf(dropped))
+		}
+	}
+
+	digits := strings.TrimLeft(intPart+frac, "0")
+	zeros := decimals - len(frac)
+	if len(digits) == 0 {
+		digits, zeros = "0", 0
+	}
+	if zeros > len(maxAbsI256Dec)-len(digits) {
+		return nil, fmt.Errorf("int256: cannot parse %q with %d decimals: %w", s, decimals, ErrOverflow)
+	}
+	dec := digits + strings.Repeat("0", zeros)
+	if neg {
+		dec = "-" + dec
+	}
+
+	z := new(Int)
+	if err := z.SetFromDec(dec); err != nil {
+		return nil, fmt.Errorf("int256: cannot parse %q with %d decimals: %w", s, decimals, err)
+	}
+	if away {
+		var (
+			r        Int
+			overflow bool
+		)
+		if neg {
+			_, overflow = r.SubOverflow(z, new(Int).SetOne())
+		} else {
+			_, overflow = r.AddOverflow(z, new(Int).SetOne())
+		}
+		if overflow {
+			return nil, fmt.Errorf("int256: cannot parse %q with %d decimals: %w", s, decimals, ErrOverflow)
+		}
+		z.Set(&r)
+	}
+	return z, nil
+}
+
+// splitSign removes a leading minus sign from s.
+func splitSign(s string) (bool, string) {
+	if len(s) > 0 && s[0] == '-' {
+		return true, s[1:]
+	}
+	return false, s
+}
+
+// splitDecimals splits a string of decimal digits into its integer part, which
+// is "0" if empty, and exactly decimals fractional digits.
+func splitDecimals(digits string, decimals int) (string, string) {
+	if len(digits) <= decimals {
+		return "0", strings.Repeat("0", decimals-len(digits)) + digits
+	}
+	return digits[:len(digits)-decimals], digits[len(digits)-decimals:]
+}
+
+func isDecimalDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// lastDigit returns the value of the last digit of s, or 0 if s is empty.
+func lastDigit(s string) byte {
+	if len(s) == 0 {
+		return 0
+	}
+	return s[len(s)-1] - '0'
+}
+
+// compareHalf compares the fraction 0.dropped with one half.
+func compareHalf(dropped string) int {
+	switch {
+	case len(dropped) == 0 || dropped[0] < '5':
+		return -1
+	case dropped[0] > '5' || strings.Trim(dropped[1:], "0") != "":
+		return 1
+	}
+	return 0
+}
+
+// roundsAway reports whether a value with a non-zero dropped fraction must be
+// rounded away from zero, given its sign, the last kept digit and how the
+// dropped fraction compares with one half. It panics if mode is not valid.
+func roundsAway(mode RoundingMode, neg bool, last byte, half int) bool {
+	switch mode {
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && last%2 == 1)
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundDown:
+		return false
+	case RoundUp:
+		return true
+	case RoundFloor:
+		return neg
+	case RoundCeiling:
+		return !neg
+	}
+	panic("int256: invalid rounding mode")
+}
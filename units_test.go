@@ -0,0 +1,131 @@
+package int256
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		dec      string
+		decimals int
+		out      string
+	}{
+		{"1500000000000000000", 18, "1.5"},
+		{"1000000000000000000", 18, "1"},
+		{"-1500000000000000000", 18, "-1.5"},
+		{"1", 18, "0.000000000000000001"},
+		{"-5", 1, "-0.5"},
+		{"0", 18, "0"},
+		{"123", 0, "123"},
+		{"1230", 2, "12.3"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 76, "-5.7896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", 80, "0.00057896044618658097711785492504343953926634992332820282019728792003956564819967"},
+	}
+	for i, test := range tests {
+		assert.Equal(t, test.out, FormatUnits(MustFromDec(test.dec), test.decimals), "test %d", i)
+	}
+
+	t.Run("should panic on decimals out of range", func(t *testing.T) {
+		assert.Panics(t, func() { FormatUnits(NewInt(1), -1) })
+		assert.Panics(t, func() { FormatUnits(NewInt(1), MaxDecimals+1) })
+		assert.Equal(t, "0."+strings.Repeat("0", MaxDecimals-1)+"1", FormatUnits(NewInt(1), MaxDecimals))
+	})
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		in       string
+		decimals int
+		out      string
+	}{
+		{"1.5", 18, "1500000000000000000"},
+		{"-1.5", 18, "-1500000000000000000"},
+		{"0.000000000000000001", 18, "1"},
+		{".5", 1, "5"},
+		{"5.", 1, "50"},
+		{"-0", 18, "0"},
+		{"1.50000", 2, "150"},
+		{"007", 0, "7"},
+		{"-5.7896044618658097711785492504343953926634992332820282019728792003956564819968", 76, "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+	}
+	for i, test := range tests {
+		z, err := ParseUnits(test.in, test.decimals)
+		assert.Nil(t, err, "test %d", i)
+		assert.Equal(t, test.out, z.Dec(), "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		errTests := []struct {
+			in       string
+			decimals int
+			err      error
+		}{
+			{"1.55", 1, ErrExcessPrecision},
+			{"", 18, ErrEmptyNumber},
+			{"-.", 18, ErrEmptyNumber},
+			{"1.2.3", 18, ErrSyntax},
+			{"1e18", 0, ErrSyntax},
+			{"+1", 0, ErrSyntax},
+			{"57896044618658097711785492504343953926634992332820282019728792003956564819968", 0, ErrOverflow},
+			{"1", 78, ErrOverflow},
+			{"1", 1000000000, ErrOverflow},
+			{"12", math.MaxInt, ErrOverflow},
+			{"0.5", math.MaxInt, ErrOverflow},
+		}
+		for i, test := range errTests {
+			_, err := ParseUnits(test.in, test.decimals)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+		_, err := ParseUnits("1", -1)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseUnitsRound(t *testing.T) {
+	inputs := []string{"5.5", "2.5", "1.6", "1.1", "-1.1", "-1.6", "-2.5", "-5.5", "1.05", "1.500001", "-0.4"}
+	expected := map[RoundingMode][]string{
+		RoundHalfEven: {"6", "2", "2", "1", "-1", "-2", "-2", "-6", "1", "2", "0"},
+		RoundHalfUp:   {"6", "3", "2", "1", "-1", "-2", "-3", "-6", "1", "2", "0"},
+		RoundHalfDown: {"5", "2", "2", "1", "-1", "-2", "-2", "-5", "1", "2", "0"},
+		RoundDown:     {"5", "2", "1", "1", "-1", "-1", "-2", "-5", "1", "1", "0"},
+		RoundUp:       {"6", "3", "2", "2", "-2", "-2", "-3", "-6", "2", "2", "-1"},
+		RoundFloor:    {"5", "2", "1", "1", "-2", "-2", "-3", "-6", "1", "1", "-1"},
+		RoundCeiling:  {"6", "3", "2", "2", "-1", "-1", "-2", "-5", "2", "2", "0"},
+	}
+	for mode, outs := range expected {
+		for i, in := range inputs {
+			z, err := ParseUnitsRound(in, 0, mode)
+			assert.Nil(t, err, "%s %s", mode, in)
+			assert.Equal(t, outs[i], z.Dec(), "mode %s input %s test %d", mode, in, i)
+		}
+	}
+
+	t.Run("should round at the given decimals", func(t *testing.T) {
+		z, err := ParseUnitsRound("1.23456789", 6, RoundHalfEven)
+		assert.Nil(t, err)
+		assert.Equal(t, "1234568", z.Dec())
+	})
+
+	t.Run("should return error overflow", func(t *testing.T) {
+		_, err := ParseUnitsRound("57896044618658097711785492504343953926634992332820282019728792003956564819967.1", 0, RoundUp)
+		assert.ErrorIs(t, err, ErrOverflow)
+		z, err := ParseUnitsRound("-57896044618658097711785492504343953926634992332820282019728792003956564819967.1", 0, RoundUp)
+		assert.Nil(t, err)
+		assert.Equal(t, MinI256, z)
+	})
+
+	t.Run("should return error invalid mode", func(t *testing.T) {
+		_, err := ParseUnitsRound("1.5", 0, 42)
+		assert.EqualError(t, err, "int256: invalid rounding mode RoundingMode(42)")
+		assert.Panics(t, func() { roundsAway(42, false, 1, 0) })
+	})
+
+	t.Run("should return string", func(t *testing.T) {
+		assert.Equal(t, "HalfEven", RoundHalfEven.String())
+		assert.Equal(t, "RoundingMode(42)", RoundingMode(42).String())
+	})
+}
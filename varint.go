@@ -0,0 +1,96 @@
+package int256
+
+import "io"
+
+// MaxVarintLen is the maximum length in bytes of the AppendVarint encoding.
+const MaxVarintLen = (256 + 6) / 7
+
+// AppendVarint appends the varint encoding of z to buf. Like encoding/binary's
+// AppendVarint it zigzag-maps z to an unsigned value, so that small negative
+// and positive numbers stay short, and writes it as little-endian base 128
+// groups with the high bit of each byte set when more bytes follow.
+func (z *Int) AppendVarint(buf []byte) []byte {
+	// zigzag: (z << 1) ^ (z >> 255)
+	var u Int
+	u.Lsh(z, 1)
+	if z.IsNegative() {
+		u.Not(&u)
+	}
+	for u[1]|u[2]|u[3] != 0 || u[0] >= 0x80 {
+		buf = append(buf, byte(u[0])|0x80)
+		u[0] = u[0]>>7 | u[1]<<57
+		u[1] = u[1]>>7 | u[2]<<57
+		u[2] = u[2]>>7 | u[3]<<57
+		u[3] >>= 7
+	}
+	return append(buf, byte(u[0]))
+}
+
+// ReadVarint decodes a varint written by AppendVarint from the start of b into
+// z and returns the remaining bytes. Encodings that are not the shortest
+// possible or that exceed 256 bits are rejected.
+func (z *Int) ReadVarint(b []byte) ([]byte, error) {
+	var u Int
+	for i := 0; i < MaxVarintLen; i++ {
+		if i == len(b) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		c := b[i]
+		shift := uint(7 * i)
+		if i == MaxVarintLen-1 && c >= 1<<(256-shift) {
+			return nil, ErrOverflow
+		}
+		v := uint64(c & 0x7f)
+		u[shift/64] |= v << (shift % 64)
+		if shift%64 > 57 && shift/64 < 3 {
+			u[shift/64+1] |= v >> (64 - shift%64)
+		}
+		if c < 0x80 {
+			if c == 0 && i > 0 {
+				return nil, ErrInvalidEncoding
+			}
+			// inverse zigzag: (u >> 1) ^ -(u & 1)
+			neg := u[0]&1 != 0
+			z[0] = u[0]>>1 | u[1]<<63
+			z[1] = u[1]>>1 | u[2]<<63
+			z[2] = u[2]>>1 | u[3]<<63
+			z[3] = u[3] >> 1
+			if neg {
+				z.Not(z)
+			}
+			return b[i+1:], nil
+		}
+	}
+	return nil, ErrOverflow
+}
+
+// AppendLengthPrefixed appends z as one length byte followed by the shortest
+// big-endian two's complement representation of z; zero is the single byte 0.
+func (z *Int) AppendLengthPrefixed(buf []byte) []byte {
+	return z.appendMinimal(append(buf, byte(z.minimalLen())))
+}
+
+// ReadLengthPrefixed decodes a value written by AppendLengthPrefixed from the
+// start of b into z and returns the remaining bytes. Values that are not in
+// their shortest form are rejected.
+func (z *Int) ReadLengthPrefixed(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	n := int(b[0])
+	if n > 32 {
+		return nil, ErrOverflow
+	}
+	if len(b) < 1+n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var x Int
+	if err := x.setMinimal(b[1 : 1+n]); err != nil {
+		return nil, err
+	}
+	if x.minimalLen() != n {
+		return nil, ErrInvalidEncoding
+	}
+	z.Set(&x)
+	return b[1+n:], nil
+}
@@ -0,0 +1,163 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVarint(t *testing.T) {
+	t.Run("1. should match encoding/binary for int64 values", func(t *testing.T) {
+		for i, v := range []int64{0, 1, -1, 63, -64, 64, 300, -300, 1 << 40, -(1 << 62), 9223372036854775807, -9223372036854775808} {
+			expected := binary.AppendVarint(nil, v)
+			b := NewInt(v).AppendVarint(nil)
+			assert.Equal(t, expected, b, "test %d", i)
+
+			var z Int
+			rest, err := z.ReadVarint(b)
+			assert.Nil(t, err, "test %d", i)
+			assert.Empty(t, rest, "test %d", i)
+			assert.Equal(t, v, z.Int64(), "test %d", i)
+		}
+	})
+
+	t.Run("2. should round trip range boundaries", func(t *testing.T) {
+		for i, z := range []*Int{MinI256, MaxI256} {
+			b := z.AppendVarint([]byte{0xaa})
+			assert.Equal(t, 1+MaxVarintLen, len(b), "test %d", i)
+
+			var d Int
+			rest, err := d.ReadVarint(append(b[1:], 0xbb))
+			assert.Nil(t, err, "test %d", i)
+			assert.Equal(t, []byte{0xbb}, rest, "test %d", i)
+			assert.Equal(t, z, &d, "test %d", i)
+		}
+	})
+
+	t.Run("3. should return error", func(t *testing.T) {
+		tests := []struct {
+			varint string
+			err    error
+		}{
+			{"", io.ErrUnexpectedEOF},
+			{"80", io.ErrUnexpectedEOF},
+			{"8000", ErrInvalidEncoding},
+			{strings.Repeat("ff", 36) + "10", ErrOverflow},
+			{strings.Repeat("ff", 37) + "01", ErrOverflow},
+		}
+		for i, test := range tests {
+			b, _ := hex.DecodeString(test.varint)
+			var z Int
+			_, err := z.ReadVarint(b)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+	})
+}
+
+func TestLengthPrefixed(t *testing.T) {
+	tests := []struct {
+		dec string
+		enc string
+	}{
+		{"0", "00"},
+		{"1", "0101"},
+		{"-1", "01ff"},
+		{"128", "020080"},
+		{"-128", "0180"},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", "2080" + strings.Repeat("00", 31)},
+	}
+	for i, test := range tests {
+		z := MustFromDec(test.dec)
+		b := z.AppendLengthPrefixed(nil)
+		assert.Equal(t, test.enc, hex.EncodeToString(b), "test %d", i)
+
+		var d Int
+		rest, err := d.ReadLengthPrefixed(b)
+		assert.Nil(t, err, "test %d", i)
+		assert.Empty(t, rest, "test %d", i)
+		assert.Equal(t, z, &d, "test %d", i)
+	}
+
+	t.Run("should return error", func(t *testing.T) {
+		tests := []struct {
+			enc string
+			err error
+		}{
+			{"", io.ErrUnexpectedEOF},
+			{"0201", io.ErrUnexpectedEOF},
+			{"21", ErrOverflow},
+			{"0100", ErrInvalidEncoding},
+			{"020001", ErrInvalidEncoding},
+			{"02ffff", ErrInvalidEncoding},
+		}
+		for i, test := range tests {
+			b, _ := hex.DecodeString(test.enc)
+			var z Int
+			_, err := z.ReadLengthPrefixed(b)
+			assert.ErrorIs(t, err, test.err, "test %d", i)
+		}
+	})
+}
+
+func varintSeeds(f *testing.F) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, v := range []*Int{new(Int), NewInt(1), NewInt(-1), MinI256, MaxI256} {
+		var b [32]byte
+		v.WriteToArray32(&b)
+		f.Add(b[:])
+	}
+	for i := 0; i < 16; i++ {
+		b := new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), uint(rnd.Intn(256)+1))).FillBytes(make([]byte, 32))
+		f.Add(b)
+	}
+}
+
+func FuzzVarint(f *testing.F) {
+	varintSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) >= 32 {
+			z := new(Int).SetBytes32(data[:32])
+			var d Int
+			rest, err := d.ReadVarint(z.AppendVarint(nil))
+			if err != nil || len(rest) != 0 || !d.Eq(z) {
+				t.Fatalf("round trip of %s failed: %v %s", z.Dec(), err, d.Dec())
+			}
+		}
+
+		// any accepted input must be canonical
+		var z Int
+		if rest, err := z.ReadVarint(data); err == nil {
+			if enc := z.AppendVarint(nil); !bytes.Equal(enc, data[:len(data)-len(rest)]) {
+				t.Fatalf("non-canonical input %x accepted as %s", data, z.Dec())
+			}
+		}
+	})
+}
+
+func FuzzLengthPrefixed(f *testing.F) {
+	varintSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) >= 32 {
+			z := new(Int).SetBytes32(data[:32])
+			var d Int
+			rest, err := d.ReadLengthPrefixed(z.AppendLengthPrefixed(nil))
+			if err != nil || len(rest) != 0 || !d.Eq(z) {
+				t.Fatalf("round trip of %s failed: %v %s", z.Dec(), err, d.Dec())
+			}
+		}
+
+		var z Int
+		if rest, err := z.ReadLengthPrefixed(data); err == nil {
+			if enc := z.AppendLengthPrefixed(nil); !bytes.Equal(enc, data[:len(data)-len(rest)]) {
+				t.Fatalf("non-canonical input %x accepted as %s", data, z.Dec())
+			}
+		}
+	})
+}